@@ -28,6 +28,7 @@ require (
 	github.com/stretchr/testify v1.5.1
 	go.uber.org/atomic v1.7.0
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	google.golang.org/protobuf v1.23.0
 )
 
 replace github.com/apache/pulsar-client-go/oauth2 => ./oauth2
@@ -18,6 +18,7 @@
 package pulsar
 
 import (
+	"context"
 	"crypto/tls"
 	"time"
 
@@ -123,6 +124,11 @@ type ClientOptions struct {
 
 	// Add custom labels to all the metrics reported by this client instance
 	CustomMetricsLabels map[string]string
+
+	// Limit of the memory used by the payloads of the pending messages of all the producers of the client.
+	// The sends exceeding the limit block, or fail with ProducerQueueIsFull if DisableBlockIfQueueFull is set.
+	// (default: 0, no limit)
+	MemoryLimitBytes int64
 }
 
 // Client represents a pulsar client
@@ -141,6 +147,16 @@ type Client interface {
 	// This method will block until the reader is created successfully.
 	CreateReader(ReaderOptions) (Reader, error)
 
+	// CreateTableView creates a TableView instance, a key/value view of the latest value of each key
+	// of a compacted topic.
+	// This method will block until the TableView has loaded the content of the topic.
+	CreateTableView(TableViewOptions) (TableView, error)
+
+	// ReadRange reads the messages of a topic between two positions, calling the handler for each of them,
+	// and returns once the end of the range has been reached on every partition. The handler is called from
+	// a single goroutine, the read stops at the first error it returns.
+	ReadRange(ctx context.Context, options RangeOptions, handler func(Message) error) error
+
 	// TopicPartitions Fetches the list of partitions for a given topic
 	//
 	// If the topic is partitioned, this will return a list of partition names.
@@ -40,6 +40,7 @@ type client struct {
 	handlers      internal.ClientHandlers
 	lookupService internal.LookupService
 	metrics       *internal.Metrics
+	memLimit      internal.MemoryLimitController
 
 	log log.Logger
 }
@@ -121,8 +122,9 @@ func newClient(options ClientOptions) (Client, error) {
 	c := &client{
 		cnxPool: internal.NewConnectionPool(tlsConfig, authProvider, connectionTimeout, maxConnectionsPerHost, logger,
 			metrics),
-		log:     logger,
-		metrics: metrics,
+		log:      logger,
+		metrics:  metrics,
+		memLimit: internal.NewMemoryLimitController(options.MemoryLimitBytes, metrics.MemoryUsed),
 	}
 	serviceNameResolver := internal.NewPulsarServiceNameResolver(url)
 
@@ -176,6 +178,15 @@ func (c *client) CreateReader(options ReaderOptions) (Reader, error) {
 	return reader, nil
 }
 
+func (c *client) CreateTableView(options TableViewOptions) (TableView, error) {
+	tableView, err := newTableView(c, options)
+	if err != nil {
+		return nil, err
+	}
+	c.handlers.Add(tableView)
+	return tableView, nil
+}
+
 func (c *client) TopicPartitions(topic string) ([]string, error) {
 	topicName, err := internal.ParseTopicName(topic)
 	if err != nil {
@@ -60,6 +60,20 @@ const (
 	SubscriptionPositionEarliest
 )
 
+// RegexSubscriptionMode selects the domain of the topics a consumer subscribes to when using a TopicsPattern
+type RegexSubscriptionMode int
+
+const (
+	// PersistentOnly only subscribe to persistent topics
+	PersistentOnly RegexSubscriptionMode = iota
+
+	// NonPersistentOnly only subscribe to non-persistent topics
+	NonPersistentOnly
+
+	// AllTopics subscribe to both persistent and non-persistent topics
+	AllTopics
+)
+
 // Configuration for Dead Letter Queue consumer policy
 type DLQPolicy struct {
 	// Maximum number of times that a message will be delivered before being sent to the dead letter queue.
@@ -89,6 +103,12 @@ type ConsumerOptions struct {
 	// Specify the interval in which to poll for new partitions or new topics if using a TopicsPattern.
 	AutoDiscoveryPeriod time.Duration
 
+	// RegexSubscriptionMode selects which topics a TopicsPattern is matched against: persistent topics,
+	// non-persistent topics or both. When the TopicsPattern is prefixed with a domain, eg. `non-persistent://`,
+	// only the topics of that domain are subscribed to and the mode must include it.
+	// Default is `PersistentOnly`
+	RegexSubscriptionMode RegexSubscriptionMode
+
 	// Specify the subscription name for this consumer
 	// This argument is required when subscribing
 	SubscriptionName string
@@ -132,6 +152,10 @@ type ConsumerOptions struct {
 	// processed. Default is 1min. (See `Consumer.Nack()`)
 	NackRedeliveryDelay time.Duration
 
+	// The timeout after which the messages received and not acknowledged are redelivered, with the messages of
+	// the same batch. Default is 0, the messages are not redelivered.
+	AckTimeout time.Duration
+
 	// Set the consumer name.
 	Name string
 
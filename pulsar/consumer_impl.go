@@ -176,6 +176,11 @@ func newConsumer(client *client, options ConsumerOptions) (Consumer, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		if hasTopicDomain(options.TopicsPattern) && !options.RegexSubscriptionMode.includes(tn.Domain) {
+			return nil, newError(InvalidConfiguration,
+				fmt.Sprintf("topics pattern %s is not allowed by the regex subscription mode", options.TopicsPattern))
+		}
 		return newRegexConsumer(client, options, tn, pattern, messageCh, dlq, rlq)
 	}
 
@@ -326,6 +331,7 @@ func (c *consumer) internalTopicSubscribeToPartitions() error {
 				partitionIdx:               idx,
 				receiverQueueSize:          receiverQueueSize,
 				nackRedeliveryDelay:        nackRedeliveryDelay,
+				ackTimeout:                 c.options.AckTimeout,
 				metadata:                   metadata,
 				replicateSubscriptionState: c.options.ReplicateSubscriptionState,
 				startMessageID:             trackingMessageID{},
@@ -48,4 +48,72 @@ func (x ConsumerInterceptors) OnNegativeAcksSend(consumer Consumer, msgIDs []Mes
 	}
 }
 
+// Filter runs the message through every FilteringConsumerInterceptor in the chain, in order. Each filter
+// receives the message returned by the previous one. The chain stops at the first filter that decides to skip
+// the message.
+func (x ConsumerInterceptors) Filter(message ConsumerMessage) (Message, ConsumeDecision) {
+	for i := range x {
+		f, ok := x[i].(FilteringConsumerInterceptor)
+		if !ok {
+			continue
+		}
+		msg, decision := f.Filter(message)
+		if decision == ConsumeSkip {
+			return nil, ConsumeSkip
+		}
+		if msg != nil {
+			message.Message = msg
+		}
+	}
+	return message.Message, ConsumeDeliver
+}
+
+func (x ConsumerInterceptors) OnAckTimeoutSend(consumer Consumer, msgIDs []MessageID) {
+	for i := range x {
+		if f, ok := x[i].(FilteringConsumerInterceptor); ok {
+			f.OnAckTimeoutSend(consumer, msgIDs)
+		}
+	}
+}
+
+func (x ConsumerInterceptors) OnConsumeError(consumer Consumer, msgID MessageID, err error) {
+	for i := range x {
+		if f, ok := x[i].(FilteringConsumerInterceptor); ok {
+			f.OnConsumeError(consumer, msgID, err)
+		}
+	}
+}
+
 var defaultConsumerInterceptors = make(ConsumerInterceptors, 0)
+
+// ConsumeDecision is the outcome of FilteringConsumerInterceptor.Filter
+type ConsumeDecision int
+
+const (
+	// ConsumeDeliver delivers the message to the application
+	ConsumeDeliver ConsumeDecision = iota
+
+	// ConsumeSkip drops the message and acknowledges it on behalf of the application
+	ConsumeSkip
+)
+
+// FilteringConsumerInterceptor is a ConsumerInterceptor that can also drop or replace messages before they are
+// handed to the application, and that is told about messages the consumer failed to process.
+// Interceptors in ConsumerOptions.Interceptors that implement it are detected automatically.
+type FilteringConsumerInterceptor interface {
+	ConsumerInterceptor
+
+	// Filter This is called before BeforeConsume. Returning ConsumeSkip drops the message and acknowledges it.
+	// Returning ConsumeDeliver with a non-nil Message replaces the payload, key, ordering key, properties and
+	// event time of the delivered message with the ones of the returned Message; the ID, topic and
+	// acknowledgement of the message are kept. Returning a nil Message delivers the message unchanged.
+	Filter(message ConsumerMessage) (Message, ConsumeDecision)
+
+	// OnAckTimeoutSend This method will be called when the messages not acknowledged before
+	// ConsumerOptions.AckTimeout are redelivered.
+	OnAckTimeoutSend(consumer Consumer, msgIDs []MessageID)
+
+	// OnConsumeError This method will be called when a message received from the broker can not be delivered,
+	// eg. because it could not be decrypted, decompressed or deserialized.
+	OnConsumeError(consumer Consumer, msgID MessageID, err error)
+}
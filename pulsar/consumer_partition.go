@@ -89,6 +89,7 @@ type partitionConsumerOpts struct {
 	partitionIdx               int
 	receiverQueueSize          int
 	nackRedeliveryDelay        time.Duration
+	ackTimeout                 time.Duration
 	metadata                   map[string]string
 	replicateSubscriptionState bool
 	startMessageID             trackingMessageID
@@ -122,13 +123,17 @@ type partitionConsumer struct {
 	messageCh chan ConsumerMessage
 
 	// the number of message slots available
-	availablePermits int32
+	availablePermits atomic.Int32
 
 	// the size of the queue channel for buffering messages
 	queueSize       int32
 	queueCh         chan []*message
 	startMessageID  trackingMessageID
 	lastDequeuedMsg trackingMessageID
+	// last message id known to be in the broker, used by readers to find out if there is more to read
+	lastMessageInBroker trackingMessageID
+	// guards lastDequeuedMsg, which is set by the dispatcher of the reader
+	lastDequeuedMsgLock sync.Mutex
 
 	eventsCh             chan interface{}
 	connectedCh          chan struct{}
@@ -139,6 +144,11 @@ type partitionConsumer struct {
 
 	nackTracker *negativeAcksTracker
 	dlq         *dlqRouter
+	// unackedTracker redelivers the messages not acknowledged before the ack timeout, nil when it is disabled
+	unackedTracker *unackedMessagesTracker
+
+	// the writer schemas of the messages, by schema version
+	schemaInfoCache *schemaInfoCache
 
 	log log.Logger
 
@@ -203,6 +213,12 @@ func newPartitionConsumer(parent Consumer, client *client, options *partitionCon
 
 	pc.nackTracker = newNegativeAcksTracker(pc, options.nackRedeliveryDelay, pc.log)
 
+	pc.schemaInfoCache = newSchemaInfoCache(pc.fetchSchema)
+	if acs, ok := options.schema.(*AutoConsumeSchema); ok {
+		// every topic has its own schemas
+		options.schema = acs.bind(pc.fetchSchema)
+	}
+
 	err := pc.grabConn()
 	if err != nil {
 		pc.log.WithError(err).Error("Failed to create consumer")
@@ -230,6 +246,10 @@ func newPartitionConsumer(parent Consumer, client *client, options *partitionCon
 		}
 	}
 
+	if options.ackTimeout > 0 {
+		pc.unackedTracker = newUnackedMessagesTracker(pc.redeliverAckTimeout, options.ackTimeout, pc.log)
+	}
+
 	go pc.dispatcher()
 
 	go pc.runEventsLoop()
@@ -279,6 +299,9 @@ func (pc *partitionConsumer) internalUnsubscribe(unsub *unsubscribeRequest) {
 	if pc.nackTracker != nil {
 		pc.nackTracker.Close()
 	}
+	if pc.unackedTracker != nil {
+		pc.unackedTracker.Close()
+	}
 	pc.log.Infof("The consumer[%d] successfully unsubscribed", pc.consumerID)
 	pc.setConsumerState(consumerClosed)
 }
@@ -292,6 +315,52 @@ func (pc *partitionConsumer) getLastMessageID() (trackingMessageID, error) {
 	return req.msgID, req.err
 }
 
+// hasNext returns true if there are messages to read after the last one dequeued
+func (pc *partitionConsumer) hasNext() bool {
+	if !pc.lastMessageInBroker.Undefined() && pc.hasMoreMessages() {
+		return true
+	}
+
+	for {
+		lastMsgID, err := pc.getLastMessageID()
+		if err != nil {
+			pc.log.WithError(err).Error("Failed to get last message id from broker")
+			continue
+		} else {
+			pc.lastMessageInBroker = lastMsgID
+			break
+		}
+	}
+
+	return pc.hasMoreMessages()
+}
+
+func (pc *partitionConsumer) setLastDequeuedMsg(msgID trackingMessageID) {
+	pc.lastDequeuedMsgLock.Lock()
+	defer pc.lastDequeuedMsgLock.Unlock()
+	pc.lastDequeuedMsg = msgID
+}
+
+func (pc *partitionConsumer) getLastDequeuedMsg() trackingMessageID {
+	pc.lastDequeuedMsgLock.Lock()
+	defer pc.lastDequeuedMsgLock.Unlock()
+	return pc.lastDequeuedMsg
+}
+
+func (pc *partitionConsumer) hasMoreMessages() bool {
+	if lastDequeuedMsg := pc.getLastDequeuedMsg(); !lastDequeuedMsg.Undefined() {
+		return pc.lastMessageInBroker.isEntryIDValid() && pc.lastMessageInBroker.greater(lastDequeuedMsg.messageID)
+	}
+
+	if pc.options.startMessageIDInclusive {
+		return pc.lastMessageInBroker.isEntryIDValid() &&
+			pc.lastMessageInBroker.greaterEqual(pc.startMessageID.messageID)
+	}
+
+	// Non-inclusive
+	return pc.lastMessageInBroker.isEntryIDValid() && pc.lastMessageInBroker.greater(pc.startMessageID.messageID)
+}
+
 func (pc *partitionConsumer) internalGetLastMessageID(req *getLastMsgIDRequest) {
 	defer close(req.doneCh)
 	req.msgID, req.err = pc.requestGetLastMessageID()
@@ -322,11 +391,18 @@ func (pc *partitionConsumer) AckID(msgID trackingMessageID) {
 		}
 		pc.eventsCh <- req
 
+		if pc.unackedTracker != nil {
+			pc.unackedTracker.Remove(msgID.messageID)
+		}
+
 		pc.options.interceptors.OnAcknowledge(pc.parentConsumer, msgID)
 	}
 }
 
 func (pc *partitionConsumer) NackID(msgID trackingMessageID) {
+	if pc.unackedTracker != nil {
+		pc.unackedTracker.Remove(msgID.messageID)
+	}
 	pc.nackTracker.Add(msgID.messageID)
 	pc.metrics.NacksCounter.Inc()
 }
@@ -341,9 +417,20 @@ func (pc *partitionConsumer) Redeliver(msgIds []messageID) {
 	pc.options.interceptors.OnNegativeAcksSend(pc.parentConsumer, iMsgIds)
 }
 
+// redeliverAckTimeout redelivers the messages which were not acknowledged before the ack timeout
+func (pc *partitionConsumer) redeliverAckTimeout(msgIds []messageID) {
+	pc.eventsCh <- &redeliveryRequest{msgIds}
+
+	iMsgIds := make([]MessageID, len(msgIds))
+	for i := range iMsgIds {
+		iMsgIds[i] = &msgIds[i]
+	}
+	pc.options.interceptors.OnAckTimeoutSend(pc.parentConsumer, iMsgIds)
+}
+
 func (pc *partitionConsumer) internalRedeliver(req *redeliveryRequest) {
 	msgIds := req.msgIds
-	pc.log.Debug("Request redelivery after negative ack for messages", msgIds)
+	pc.log.Debug("Request redelivery for messages", msgIds)
 
 	msgIDDataList := make([]*pb.MessageIdData, len(msgIds))
 	for i := 0; i < len(msgIds); i++ {
@@ -502,6 +589,7 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 	msgMeta, err := reader.ReadMessageMetadata()
 	if err != nil {
 		pc.discardCorruptedMessage(pbMsgID, pb.CommandAck_ChecksumMismatch)
+		pc.reportConsumeError(pbMsgID, err)
 		return err
 	}
 
@@ -518,9 +606,11 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 		case crypto.ConsumerCryptoFailureActionFail:
 			pc.log.Errorf("consuming message failed due to decryption err :%v", err)
 			pc.NackID(newTrackingMessageID(int64(pbMsgID.GetLedgerId()), int64(pbMsgID.GetEntryId()), 0, 0, nil))
+			pc.reportConsumeError(pbMsgID, err)
 			return err
 		case crypto.ConsumerCryptoFailureActionDiscard:
 			pc.discardCorruptedMessage(pbMsgID, pb.CommandAck_DecryptionError)
+			pc.reportConsumeError(pbMsgID, err)
 			return fmt.Errorf("discarding message on decryption error :%v", err)
 		case crypto.ConsumerCryptoFailureActionConsume:
 			pc.log.Warnf("consuming encrypted message due to error in decryption :%v", err)
@@ -540,6 +630,8 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 					),
 					payLoad:             headersAndPayload.ReadableSlice(),
 					schema:              pc.options.schema,
+					schemaVersion:       msgMeta.GetSchemaVersion(),
+					schemaInfoCache:     pc.schemaInfoCache,
 					replicationClusters: msgMeta.GetReplicateTo(),
 					replicatedFrom:      msgMeta.GetReplicatedFrom(),
 					redeliveryCount:     response.GetRedeliveryCount(),
@@ -555,6 +647,7 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 	uncompressedHeadersAndPayload, err := pc.Decompress(msgMeta, internal.NewBufferWrapper(decryptedPayload))
 	if err != nil {
 		pc.discardCorruptedMessage(pbMsgID, pb.CommandAck_DecompressionError)
+		pc.reportConsumeError(pbMsgID, err)
 		return err
 	}
 
@@ -580,6 +673,7 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 		smm, payload, err := reader.ReadMessage()
 		if err != nil {
 			pc.discardCorruptedMessage(pbMsgID, pb.CommandAck_BatchDeSerializeError)
+			pc.reportConsumeError(pbMsgID, err)
 			return err
 		}
 
@@ -612,6 +706,8 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 				msgID:               msgID,
 				payLoad:             payload,
 				schema:              pc.options.schema,
+				schemaVersion:       msgMeta.GetSchemaVersion(),
+				schemaInfoCache:     pc.schemaInfoCache,
 				replicationClusters: msgMeta.GetReplicateTo(),
 				replicatedFrom:      msgMeta.GetReplicatedFrom(),
 				redeliveryCount:     response.GetRedeliveryCount(),
@@ -627,12 +723,26 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 				msgID:               msgID,
 				payLoad:             payload,
 				schema:              pc.options.schema,
+				schemaVersion:       msgMeta.GetSchemaVersion(),
+				schemaInfoCache:     pc.schemaInfoCache,
 				replicationClusters: msgMeta.GetReplicateTo(),
 				replicatedFrom:      msgMeta.GetReplicatedFrom(),
 				redeliveryCount:     response.GetRedeliveryCount(),
 			}
 		}
 
+		filtered, decision := pc.options.interceptors.Filter(ConsumerMessage{
+			Consumer: pc.parentConsumer,
+			Message:  msg,
+		})
+		if decision == ConsumeSkip {
+			pc.AckID(msgID)
+			// the skipped messages never reach the dispatcher, give their permits back to the broker
+			pc.increaseAvailablePermits(1)
+			continue
+		}
+		msg = msg.override(filtered)
+
 		pc.options.interceptors.BeforeConsume(ConsumerMessage{
 			Consumer: pc.parentConsumer,
 			Message:  msg,
@@ -646,6 +756,28 @@ func (pc *partitionConsumer) MessageReceived(response *pb.CommandMessage, header
 	return nil
 }
 
+// increaseAvailablePermits returns the permits of consumed messages, sending them to the broker once they
+// reach half of the receiver queue. It is called from the dispatcher and from the connection.
+func (pc *partitionConsumer) increaseAvailablePermits(delta int32) {
+	// TODO implement a better flow controller
+	// send more permits if needed
+	flowThreshold := int32(math.Max(float64(pc.queueSize/2), 1))
+	if pc.availablePermits.Add(delta) < flowThreshold {
+		return
+	}
+
+	requestedPermits := pc.availablePermits.Swap(0)
+	if requestedPermits <= 0 {
+		// another caller already requested them
+		return
+	}
+
+	pc.log.Debugf("requesting more permits=%d", requestedPermits)
+	if err := pc.internalFlow(uint32(requestedPermits)); err != nil {
+		pc.log.WithError(err).Error("unable to send permits")
+	}
+}
+
 func (pc *partitionConsumer) messageShouldBeDiscarded(msgID trackingMessageID) bool {
 	if pc.startMessageID.Undefined() {
 		return false
@@ -768,7 +900,7 @@ func (pc *partitionConsumer) dispatcher() {
 			messages = nil
 
 			// reset available permits
-			pc.availablePermits = 0
+			pc.availablePermits.Store(0)
 			initialPermits := uint32(pc.queueSize)
 
 			pc.log.Debugf("dispatcher requesting initial permits=%d", initialPermits)
@@ -787,24 +919,15 @@ func (pc *partitionConsumer) dispatcher() {
 
 		// if the messageCh is nil or the messageCh is full this will not be selected
 		case messageCh <- nextMessage:
+			if mid, ok := toTrackingMessageID(messages[0].msgID); ok && pc.unackedTracker != nil {
+				pc.unackedTracker.Add(mid.messageID)
+			}
+
 			// allow this message to be garbage collected
 			messages[0] = nil
 			messages = messages[1:]
 
-			// TODO implement a better flow controller
-			// send more permits if needed
-			pc.availablePermits++
-			flowThreshold := int32(math.Max(float64(pc.queueSize/2), 1))
-			if pc.availablePermits >= flowThreshold {
-				availablePermits := pc.availablePermits
-				requestedPermits := availablePermits
-				pc.availablePermits = 0
-
-				pc.log.Debugf("requesting more permits=%d available=%d", requestedPermits, availablePermits)
-				if err := pc.internalFlow(uint32(requestedPermits)); err != nil {
-					pc.log.WithError(err).Error("unable to send permits")
-				}
-			}
+			pc.increaseAvailablePermits(1)
 
 		case clearQueueCb := <-pc.clearQueueCh:
 			// drain the message queue on any new connection by sending a
@@ -834,7 +957,7 @@ func (pc *partitionConsumer) dispatcher() {
 			messages = nil
 
 			// reset available permits
-			pc.availablePermits = 0
+			pc.availablePermits.Store(0)
 			initialPermits := uint32(pc.queueSize)
 
 			pc.log.Debugf("dispatcher requesting initial permits=%d", initialPermits)
@@ -932,6 +1055,9 @@ func (pc *partitionConsumer) internalClose(req *closeRequest) {
 		if pc.nackTracker != nil {
 			pc.nackTracker.Close()
 		}
+		if pc.unackedTracker != nil {
+			pc.unackedTracker.Close()
+		}
 		return
 	}
 
@@ -940,6 +1066,9 @@ func (pc *partitionConsumer) internalClose(req *closeRequest) {
 		if pc.nackTracker != nil {
 			pc.nackTracker.Close()
 		}
+		if pc.unackedTracker != nil {
+			pc.unackedTracker.Close()
+		}
 		return
 	}
 
@@ -969,6 +1098,9 @@ func (pc *partitionConsumer) internalClose(req *closeRequest) {
 	if pc.nackTracker != nil {
 		pc.nackTracker.Close()
 	}
+	if pc.unackedTracker != nil {
+		pc.unackedTracker.Close()
+	}
 	close(pc.closeCh)
 }
 
@@ -1028,7 +1160,10 @@ func (pc *partitionConsumer) grabConn() error {
 
 	pbSchema := new(pb.Schema)
 
-	if pc.options.schema != nil && pc.options.schema.GetSchemaInfo() != nil {
+	if _, ok := pc.options.schema.(*AutoConsumeSchema); ok {
+		// the messages are decoded with the schema of the topic
+		pbSchema = nil
+	} else if pc.options.schema != nil && pc.options.schema.GetSchemaInfo() != nil {
 		tmpSchemaType := pb.Schema_Type(int32(pc.options.schema.GetSchemaInfo().Type))
 		pbSchema = &pb.Schema{
 			Name:       proto.String(pc.options.schema.GetSchemaInfo().Name),
@@ -1095,6 +1230,11 @@ func (pc *partitionConsumer) grabConn() error {
 
 	switch msgType {
 	case pb.BaseCommand_SUCCESS:
+		if acs, ok := pc.options.schema.(*AutoConsumeSchema); ok {
+			if err := acs.fetchLatest(); err != nil {
+				pc.log.WithError(err).Warn("Failed to fetch the schema of the topic")
+			}
+		}
 		// notify the dispatcher we have connection
 		go func() {
 			pc.connectedCh <- struct{}{}
@@ -1108,6 +1248,15 @@ func (pc *partitionConsumer) grabConn() error {
 	}
 }
 
+// fetchSchema returns the schema of the topic with the given version, or the latest one when nil
+func (pc *partitionConsumer) fetchSchema(schemaVersion []byte) (*SchemaInfo, error) {
+	schema, err := pc.client.lookupService.GetSchema(pc.topic, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoSchema(schema), nil
+}
+
 func (pc *partitionConsumer) clearQueueAndGetNextMessage() trackingMessageID {
 	if pc.getConsumerState() != consumerReady {
 		return trackingMessageID{}
@@ -1136,12 +1285,13 @@ func (pc *partitionConsumer) clearReceiverQueue() trackingMessageID {
 		return pc.startMessageID
 	}
 
+	lastDequeuedMsg := pc.getLastDequeuedMsg()
 	if !nextMessageInQueue.Undefined() {
 		return getPreviousMessage(nextMessageInQueue)
-	} else if !pc.lastDequeuedMsg.Undefined() {
+	} else if !lastDequeuedMsg.Undefined() {
 		// If the queue was empty we need to restart from the message just after the last one that has been dequeued
 		// in the past
-		return pc.lastDequeuedMsg
+		return lastDequeuedMsg
 	} else {
 		// No message was received or dequeued by this consumer. Next message would still be the startMessageId
 		return pc.startMessageID
@@ -1233,6 +1383,13 @@ func (pc *partitionConsumer) discardCorruptedMessage(msgID *pb.MessageIdData,
 		})
 }
 
+// reportConsumeError notifies the interceptors that the message could not be delivered to the application
+func (pc *partitionConsumer) reportConsumeError(pbMsgID *pb.MessageIdData, err error) {
+	msgID := newMessageID(int64(pbMsgID.GetLedgerId()), int64(pbMsgID.GetEntryId()), pbMsgID.GetBatchIndex(),
+		pc.partitionIdx)
+	pc.options.interceptors.OnConsumeError(pc.parentConsumer, msgID, err)
+}
+
 // _setConn sets the internal connection field of this partition consumer atomically.
 // Note: should only be called by this partition consumer when a new connection is available.
 func (pc *partitionConsumer) _setConn(conn internal.Connection) {
@@ -46,6 +46,8 @@ type regexConsumer struct {
 
 	namespace string
 	pattern   *regexp.Regexp
+	// domain of the topics to subscribe to, empty when the pattern matches the topics of every domain
+	domain string
 
 	consumersLock sync.Mutex
 	consumers     map[string]Consumer
@@ -55,7 +57,9 @@ type regexConsumer struct {
 	closeOnce sync.Once
 	closeCh   chan struct{}
 
-	ticker *time.Ticker
+	// watcher is set when the broker pushes the topic list changes, otherwise the topics are polled
+	watcher *topicListWatcher
+	ticker  *time.Ticker
 
 	log log.Logger
 
@@ -84,6 +88,10 @@ func newRegexConsumer(c *client, opts ConsumerOptions, tn *internal.TopicName, p
 		consumerName: opts.Name,
 	}
 
+	if hasTopicDomain(opts.TopicsPattern) {
+		rc.domain = tn.Domain
+	}
+
 	topics, err := rc.topics()
 	if err != nil {
 		return nil, err
@@ -105,12 +113,23 @@ func newRegexConsumer(c *client, opts ConsumerOptions, tn *internal.TopicName, p
 		return nil, errs
 	}
 
-	// set up timer
 	duration := opts.AutoDiscoveryPeriod
 	if duration <= 0 {
 		duration = defaultAutoDiscoveryDuration
 	}
-	rc.ticker = time.NewTicker(duration)
+
+	// the broker can only watch the persistent topics
+	if opts.RegexSubscriptionMode == PersistentOnly {
+		rc.watcher, err = newTopicListWatcher(c, tn, pattern, rc.topicsChanged, duration, rc.log)
+		if err != nil {
+			rc.log.WithError(err).Info("Unable to watch the topic list, falling back to polling")
+		}
+	}
+
+	if rc.watcher == nil {
+		// set up timer
+		rc.ticker = time.NewTicker(duration)
+	}
 
 	go rc.monitor()
 
@@ -203,7 +222,12 @@ func (c *regexConsumer) NackID(msgID MessageID) {
 
 func (c *regexConsumer) Close() {
 	c.closeOnce.Do(func() {
-		c.ticker.Stop()
+		if c.watcher != nil {
+			c.watcher.close()
+		}
+		if c.ticker != nil {
+			c.ticker.Stop()
+		}
 		close(c.closeCh)
 
 		var wg sync.WaitGroup
@@ -246,11 +270,16 @@ func (c *regexConsumer) closed() bool {
 }
 
 func (c *regexConsumer) monitor() {
+	var tickerCh <-chan time.Time
+	if c.ticker != nil {
+		tickerCh = c.ticker.C
+	}
+
 	for {
 		select {
 		case <-c.closeCh:
 			return
-		case <-c.ticker.C:
+		case <-tickerCh:
 			c.log.Debug("Auto discovering topics")
 			if !c.closed() {
 				c.discover()
@@ -273,6 +302,16 @@ func (c *regexConsumer) discover() {
 		c.log.WithError(err).Errorf("Failed to discover topics")
 		return
 	}
+	c.reconcile(topics)
+}
+
+// topicsChanged is called by the topic list watcher with all the topics matching the pattern
+func (c *regexConsumer) topicsChanged(topics []string) {
+	c.reconcile(filterTopics(topics, c.pattern))
+}
+
+// reconcile subscribes to the new topics and unsubscribes from the ones that are gone
+func (c *regexConsumer) reconcile(topics []string) {
 	known := c.knownTopics()
 	newTopics := topicsDiff(topics, known)
 	staleTopics := topicsDiff(known, topics)
@@ -284,8 +323,15 @@ func (c *regexConsumer) discover() {
 		}).
 		Debug("discover topics")
 
-	c.unsubscribeCh <- staleTopics
-	c.subscribeCh <- newTopics
+	select {
+	case c.unsubscribeCh <- staleTopics:
+	case <-c.closeCh:
+		return
+	}
+	select {
+	case c.subscribeCh <- newTopics:
+	case <-c.closeCh:
+	}
 }
 
 func (c *regexConsumer) knownTopics() []string {
@@ -344,15 +390,65 @@ func (c *regexConsumer) unsubscribe(topics []string) {
 }
 
 func (c *regexConsumer) topics() ([]string, error) {
-	topics, err := c.client.lookupService.GetTopicsOfNamespace(c.namespace, internal.Persistent)
+	return discoverTopics(c.client, c.namespace, c.options.RegexSubscriptionMode, c.domain, c.pattern)
+}
+
+// discoverTopics returns the topics of the namespace allowed by the mode and matching the pattern,
+// when domain is not empty only the topics of this domain are returned
+func discoverTopics(client *client, namespace string, mode RegexSubscriptionMode, domain string,
+	pattern *regexp.Regexp) ([]string, error) {
+	topics, err := client.lookupService.GetTopicsOfNamespace(namespace, mode.lookupMode())
 	if err != nil {
 		return nil, err
 	}
 
-	filtered := filterTopics(topics, c.pattern)
+	if domain != "" {
+		topics = filterTopicsByDomain(topics, domain)
+	}
+
+	filtered := filterTopics(topics, pattern)
 	return filtered, nil
 }
 
+func (m RegexSubscriptionMode) lookupMode() internal.GetTopicsOfNamespaceMode {
+	switch m {
+	case NonPersistentOnly:
+		return internal.NonPersistent
+	case AllTopics:
+		return internal.All
+	default:
+		return internal.Persistent
+	}
+}
+
+// includes returns true if the topics of the given domain can be subscribed to with this mode
+func (m RegexSubscriptionMode) includes(domain string) bool {
+	switch m {
+	case NonPersistentOnly:
+		return domain == "non-persistent"
+	case AllTopics:
+		return true
+	default:
+		return domain == "persistent"
+	}
+}
+
+// hasTopicDomain returns true if the topic name or pattern starts with an explicit domain, eg. `persistent://`
+func hasTopicDomain(topic string) bool {
+	return strings.Contains(topic, "://")
+}
+
+func filterTopicsByDomain(topics []string, domain string) []string {
+	filtered := make([]string, 0, len(topics))
+	for _, t := range topics {
+		tn, err := internal.ParseTopicName(t)
+		if err == nil && tn.Domain == domain {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
 type consumerError struct {
 	err      error
 	topic    string
@@ -91,6 +91,29 @@ func runFilterTopicsMatchingFoo(t *testing.T) {
 	assert.Equal(t, "persistent://public/foo/foobar", matching[0])
 }
 
+func TestFilterTopicsByDomain(t *testing.T) {
+	topics := []string{
+		"persistent://public/default/foo",
+		"non-persistent://public/default/foo-telemetry",
+		"non-persistent://public/default/bar-partition-0",
+	}
+	assert.Equal(t, topics[:1], filterTopicsByDomain(topics, "persistent"))
+	assert.Equal(t, topics[1:], filterTopicsByDomain(topics, "non-persistent"))
+}
+
+func TestRegexSubscriptionMode(t *testing.T) {
+	assert.Equal(t, internal.Persistent, PersistentOnly.lookupMode())
+	assert.Equal(t, internal.NonPersistent, NonPersistentOnly.lookupMode())
+	assert.Equal(t, internal.All, AllTopics.lookupMode())
+
+	assert.True(t, PersistentOnly.includes("persistent"))
+	assert.False(t, PersistentOnly.includes("non-persistent"))
+	assert.False(t, NonPersistentOnly.includes("persistent"))
+	assert.True(t, NonPersistentOnly.includes("non-persistent"))
+	assert.True(t, AllTopics.includes("persistent"))
+	assert.True(t, AllTopics.includes("non-persistent"))
+}
+
 func TestTopicsDiff(t *testing.T) {
 	topics1 := []string{
 		"my-topic-a",
@@ -241,6 +264,77 @@ func runRegexConsumerDiscoverPatternFoo(t *testing.T, c Client, namespace string
 func TestRegexConsumer(t *testing.T) {
 	t.Run("MatchOneTopic", runWithClientNamespace(runRegexConsumerMatchOneTopic))
 	t.Run("AddTopic", runWithClientNamespace(runRegexConsumerAddMatchingTopic))
+	t.Run("NonPersistentTopic", runWithClientNamespace(runRegexConsumerMatchNonPersistentTopic))
+}
+
+func runRegexConsumerMatchNonPersistentTopic(t *testing.T, c Client, namespace string) {
+	persistentTopic := fmt.Sprintf("persistent://%s/foo-persistent", namespace)
+	nonPersistentTopic := fmt.Sprintf("non-persistent://%s/foo-non-persistent", namespace)
+
+	p1, err := c.CreateProducer(ProducerOptions{
+		Topic:           persistentTopic,
+		DisableBatching: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p1.Close()
+
+	p2, err := c.CreateProducer(ProducerOptions{
+		Topic:           nonPersistentTopic,
+		DisableBatching: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p2.Close()
+
+	// the pattern domain is not allowed by the default mode
+	_, err = c.Subscribe(ConsumerOptions{
+		TopicsPattern:    fmt.Sprintf("non-persistent://%s/foo.*", namespace),
+		SubscriptionName: "regex-sub",
+	})
+	assert.NotNil(t, err)
+
+	consumer, err := c.Subscribe(ConsumerOptions{
+		TopicsPattern:         fmt.Sprintf("non-persistent://%s/foo.*", namespace),
+		RegexSubscriptionMode: AllTopics,
+		SubscriptionName:      "regex-sub",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close()
+
+	rc := consumer.(*regexConsumer)
+	consumers := cloneConsumers(rc)
+	assert.Equal(t, 1, len(consumers))
+	assert.Contains(t, consumers, nonPersistentTopic)
+
+	err = genMessages(p1, 5, func(idx int) string {
+		return fmt.Sprintf("persistent-message-%d", idx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = genMessages(p2, 5, func(idx int) string {
+		return fmt.Sprintf("foo-message-%d", idx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		m, err := consumer.Receive(ctx)
+		if err != nil {
+			t.Errorf("failed to receive message error: %+v", err)
+		} else {
+			assert.Truef(t, strings.HasPrefix(string(m.Payload()), "foo-"),
+				"message does not start with foo: %s", string(m.Payload()))
+		}
+	}
 }
 
 func runRegexConsumerMatchOneTopic(t *testing.T, c Client, namespace string) {
@@ -18,6 +18,7 @@
 package pulsar
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -1802,6 +1803,212 @@ func TestConsumerWithInterceptors(t *testing.T) {
 	assert.Equal(t, int32(5), atomic.LoadInt32(&metric.nackn))
 }
 
+// upperCaseMessage replaces the payload of the wrapped message
+type upperCaseMessage struct {
+	Message
+}
+
+func (m upperCaseMessage) Payload() []byte {
+	return bytes.ToUpper(m.Message.Payload())
+}
+
+// tenantFilterInterceptor skips the messages of other tenants and upper-cases the payload of the others
+type tenantFilterInterceptor struct {
+	noopConsumerInterceptor
+	tenant string
+}
+
+func (x tenantFilterInterceptor) Filter(message ConsumerMessage) (Message, ConsumeDecision) {
+	if message.Properties()["tenant"] != x.tenant {
+		return nil, ConsumeSkip
+	}
+	return upperCaseMessage{message.Message}, ConsumeDeliver
+}
+
+func (tenantFilterInterceptor) OnAckTimeoutSend(consumer Consumer, msgIDs []MessageID) {}
+
+func (tenantFilterInterceptor) OnConsumeError(consumer Consumer, msgID MessageID, err error) {}
+
+func TestConsumerWithFilteringInterceptors(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	metric := &metricConsumerInterceptor{}
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:            topic,
+		SubscriptionName: "my-sub",
+		Type:             Exclusive,
+		Interceptors: ConsumerInterceptors{
+			tenantFilterInterceptor{tenant: "tenant-a"},
+			copyPropertyInterceptor{suffix: "-copy"},
+			metric,
+		},
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:           topic,
+		DisableBatching: false,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	for i := 0; i < 10; i++ {
+		tenant := "tenant-a"
+		if i%2 == 1 {
+			tenant = "tenant-b"
+		}
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Payload:    []byte(fmt.Sprintf("hello-%d", i)),
+			Properties: map[string]string{"tenant": tenant},
+		})
+		assert.Nil(t, err)
+	}
+
+	// only the messages of tenant-a are delivered, transformed by the filter
+	for i := 0; i < 5; i++ {
+		msg, err := consumer.Receive(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("HELLO-%d", i*2)), msg.Payload())
+		assert.Equal(t, "tenant-a", msg.Properties()["tenant"])
+		assert.Equal(t, "tenant-a", msg.Properties()["tenant-copy"])
+		consumer.Ack(msg)
+	}
+
+	// the skipped messages have been acknowledged on behalf of the application
+	assert.Equal(t, int32(10), atomic.LoadInt32(&metric.ackn))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	_, err = consumer.Receive(timeoutCtx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestConsumerFilteringInterceptorsReturnPermits(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:             topic,
+		SubscriptionName:  "my-sub",
+		Type:              Exclusive,
+		ReceiverQueueSize: 10,
+		Interceptors: ConsumerInterceptors{
+			tenantFilterInterceptor{tenant: "tenant-a"},
+		},
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:           topic,
+		DisableBatching: true,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	// most of the messages are skipped, more than the receiver queue can hold
+	for i := 0; i < 100; i++ {
+		tenant := "tenant-b"
+		if i%20 == 19 {
+			tenant = "tenant-a"
+		}
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Payload:    []byte(fmt.Sprintf("hello-%d", i)),
+			Properties: map[string]string{"tenant": tenant},
+		})
+		assert.Nil(t, err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for i := 0; i < 5; i++ {
+		msg, err := consumer.Receive(timeoutCtx)
+		assert.Nil(t, err)
+		if err != nil {
+			return
+		}
+		assert.Equal(t, []byte(fmt.Sprintf("HELLO-%d", i*20+19)), msg.Payload())
+		consumer.Ack(msg)
+	}
+}
+
+type ackTimeoutInterceptor struct {
+	tenantFilterInterceptor
+	ackTimeouts chan []MessageID
+}
+
+func (x ackTimeoutInterceptor) OnAckTimeoutSend(consumer Consumer, msgIDs []MessageID) {
+	x.ackTimeouts <- msgIDs
+}
+
+func TestConsumerAckTimeout(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	interceptor := ackTimeoutInterceptor{ackTimeouts: make(chan []MessageID, 10)}
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:            topic,
+		SubscriptionName: "my-sub",
+		Type:             Shared,
+		AckTimeout:       time.Second,
+		Interceptors:     ConsumerInterceptors{interceptor},
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:           topic,
+		DisableBatching: true,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	_, err = producer.Send(ctx, &ProducerMessage{
+		Payload:    []byte("hello"),
+		Properties: map[string]string{"tenant": "tenant-a"},
+	})
+	assert.Nil(t, err)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	msg, err := consumer.Receive(timeoutCtx)
+	assert.Nil(t, err)
+
+	// the message is not acknowledged, it is redelivered after the ack timeout
+	msgIDs := <-interceptor.ackTimeouts
+	assert.Equal(t, 1, len(msgIDs))
+	assert.Equal(t, msg.ID().EntryID(), msgIDs[0].EntryID())
+
+	redelivered, err := consumer.Receive(timeoutCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, msg.ID().EntryID(), redelivered.ID().EntryID())
+	consumer.Ack(redelivered)
+}
+
 func TestConsumerName(t *testing.T) {
 	assert := assert.New(t)
 
@@ -101,6 +101,12 @@ const (
 	SeekFailed
 	// ProducerClosed means producer already been closed
 	ProducerClosed
+	// MessageRejected means a producer interceptor refused to send the message
+	MessageRejected
+	// ProducerBusy means the topic already has a producer and refuses another one with its access mode
+	ProducerBusy
+	// ProducerFenced means another producer took the exclusive access to the topic
+	ProducerFenced
 )
 
 // Error implement error interface, composed of two parts: msg and result.
@@ -205,6 +211,12 @@ func getResultStr(r Result) string {
 		return "SeekFailed"
 	case ProducerClosed:
 		return "ProducerClosed"
+	case MessageRejected:
+		return "MessageRejected"
+	case ProducerBusy:
+		return "ProducerBusy"
+	case ProducerFenced:
+		return "ProducerFenced"
 	default:
 		return fmt.Sprintf("Result(%d)", r)
 	}
@@ -0,0 +1,555 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GenericRecord is a record decoded without its Go type, using the schema of the topic. The values of the
+// nested records are *GenericRecord, the values of arrays and maps are []interface{} and map[string]interface{}.
+type GenericRecord struct {
+	schemaInfo *SchemaInfo
+	fields     []string
+	values     map[string]interface{}
+}
+
+// SchemaInfo returns the schema the record was decoded with
+func (r *GenericRecord) SchemaInfo() *SchemaInfo {
+	return r.schemaInfo
+}
+
+// SchemaType returns the type of the schema the record was decoded with
+func (r *GenericRecord) SchemaType() SchemaType {
+	return r.schemaInfo.Type
+}
+
+// Fields returns the names of the fields of the record, in the order of the schema definition
+func (r *GenericRecord) Fields() []string {
+	return r.fields
+}
+
+// Field returns the value of the field, nil when the record has no such field
+func (r *GenericRecord) Field(name string) interface{} {
+	return r.values[name]
+}
+
+func (r *GenericRecord) String() string {
+	var b strings.Builder
+	b.WriteString("{")
+	for i, f := range r.fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %v", f, r.values[f])
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// genericSchema decodes the payloads of one version of the schema of a topic into generic records
+type genericSchema struct {
+	info  *SchemaInfo
+	codec *goavro.Codec
+	// definition is the parsed Avro definition of the schema, which Avro, JSON and Protobuf schemas all carry
+	definition interface{}
+	// named holds the named types of the Avro definition
+	named *avroNames
+	// fieldNumbers holds the field numbers of the top level Protobuf message by field name
+	fieldNumbers map[string]uint64
+	// descriptor is the descriptor of the Protobuf native messages
+	descriptor protoreflect.MessageDescriptor
+}
+
+// protobufParsingInfo is the property of the Protobuf schemas of the Java client giving the field numbers
+const protobufParsingInfo = "__PARSING_INFO__"
+
+func newGenericSchema(info *SchemaInfo) (*genericSchema, error) {
+	gs := &genericSchema{
+		info:  info,
+		named: newAvroNames(),
+	}
+
+	switch info.Type {
+	case AVRO, JSON, PROTOBUF:
+	case ProtoNative:
+		descriptor, err := protoNativeDescriptor(info.Schema)
+		if err != nil {
+			return nil, err
+		}
+		gs.descriptor = descriptor
+		return gs, nil
+	default:
+		return nil, newError(InvalidMessage,
+			fmt.Sprintf("schema type %s cannot be decoded into a GenericRecord", schemaTypeNames[info.Type]))
+	}
+
+	if info.Schema != "" {
+		if err := json.Unmarshal([]byte(info.Schema), &gs.definition); err != nil {
+			return nil, err
+		}
+		gs.named.register(gs.definition, "")
+	}
+
+	switch info.Type {
+	case AVRO:
+		codec, err := goavro.NewCodec(info.Schema)
+		if err != nil {
+			return nil, err
+		}
+		gs.codec = codec
+	case PROTOBUF:
+		if _, ok := gs.definition.(map[string]interface{}); !ok {
+			return nil, newError(InvalidMessage, "the Protobuf schema has no record definition")
+		}
+		if parsingInfo, ok := info.Properties[protobufParsingInfo]; ok {
+			var fields []struct {
+				Number uint64 `json:"number"`
+				Name   string `json:"name"`
+			}
+			if err := json.Unmarshal([]byte(parsingInfo), &fields); err == nil {
+				gs.fieldNumbers = make(map[string]uint64, len(fields))
+				for _, f := range fields {
+					gs.fieldNumbers[f.Name] = f.Number
+				}
+			}
+		}
+	}
+	return gs, nil
+}
+
+func (gs *genericSchema) decode(data []byte) (*GenericRecord, error) {
+	var value interface{}
+	switch gs.info.Type {
+	case AVRO:
+		native, _, err := gs.codec.NativeFromBinary(data)
+		if err != nil {
+			return nil, err
+		}
+		value = gs.fromAvro(gs.definition, native)
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var decoded interface{}
+		if err := decoder.Decode(&decoded); err != nil {
+			return nil, err
+		}
+		value = gs.fromJSON(gs.definition, decoded)
+	case PROTOBUF:
+		record, err := gs.fromProtobuf(gs.definition.(map[string]interface{}), data, gs.fieldNumbers)
+		if err != nil {
+			return nil, err
+		}
+		value = record
+	case ProtoNative:
+		message, err := unmarshalDynamic(gs.descriptor, data)
+		if err != nil {
+			return nil, err
+		}
+		value = fromProtoNative(gs.info, message)
+	}
+
+	record, ok := value.(*GenericRecord)
+	if !ok {
+		return nil, newError(InvalidMessage, fmt.Sprintf("the message is not a record: %v", value))
+	}
+	return record, nil
+}
+
+func (gs *genericSchema) newRecord(definition map[string]interface{}, value func(name string,
+	fieldType interface{}) interface{}) *GenericRecord {
+	fields, _ := definition["fields"].([]interface{})
+	record := &GenericRecord{
+		schemaInfo: gs.info,
+		fields:     make([]string, 0, len(fields)),
+		values:     make(map[string]interface{}, len(fields)),
+	}
+	for _, f := range fields {
+		fm, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := fm["name"].(string)
+		record.fields = append(record.fields, name)
+		record.values[name] = value(name, fm["type"])
+	}
+	return record
+}
+
+// fromAvro converts the native form of goavro, unions being maps from the branch name to the value
+func (gs *genericSchema) fromAvro(definition, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	switch d := gs.named.resolve(definition).(type) {
+	case []interface{}:
+		union, ok := value.(map[string]interface{})
+		if !ok || len(union) != 1 {
+			return value
+		}
+		for branchName, v := range union {
+			for _, branch := range d {
+				if gs.named.branchName(branch) == branchName {
+					return gs.fromAvro(branch, v)
+				}
+			}
+			return v
+		}
+	case map[string]interface{}:
+		switch d["type"] {
+		case "record", "error":
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return value
+			}
+			return gs.newRecord(d, func(name string, fieldType interface{}) interface{} {
+				return gs.fromAvro(fieldType, m[name])
+			})
+		case "array":
+			if items, ok := value.([]interface{}); ok {
+				converted := make([]interface{}, len(items))
+				for i, item := range items {
+					converted[i] = gs.fromAvro(d["items"], item)
+				}
+				return converted
+			}
+		case "map":
+			if values, ok := value.(map[string]interface{}); ok {
+				converted := make(map[string]interface{}, len(values))
+				for k, v := range values {
+					converted[k] = gs.fromAvro(d["values"], v)
+				}
+				return converted
+			}
+		case "enum", "fixed":
+			return value
+		default:
+			return gs.fromAvro(d["type"], value)
+		}
+	}
+	return value
+}
+
+// fromJSON converts a JSON document decoded with json.Number, objects being records
+func (gs *genericSchema) fromJSON(definition, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	switch d := gs.named.resolve(definition).(type) {
+	case string:
+		if n, ok := value.(json.Number); ok {
+			switch d {
+			case "float", "double":
+				f, _ := n.Float64()
+				return f
+			case "int", "long":
+				if i, err := n.Int64(); err == nil {
+					return i
+				}
+			}
+		}
+	case []interface{}:
+		for _, branch := range d {
+			if gs.jsonMatches(branch, value) {
+				return gs.fromJSON(branch, value)
+			}
+		}
+	case map[string]interface{}:
+		switch d["type"] {
+		case "record", "error":
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				break
+			}
+			return gs.newRecord(d, func(name string, fieldType interface{}) interface{} {
+				return gs.fromJSON(fieldType, m[name])
+			})
+		case "array":
+			if items, ok := value.([]interface{}); ok {
+				converted := make([]interface{}, len(items))
+				for i, item := range items {
+					converted[i] = gs.fromJSON(d["items"], item)
+				}
+				return converted
+			}
+		case "map":
+			if values, ok := value.(map[string]interface{}); ok {
+				converted := make(map[string]interface{}, len(values))
+				for k, v := range values {
+					converted[k] = gs.fromJSON(d["values"], v)
+				}
+				return converted
+			}
+		case "enum", "fixed":
+			return value
+		default:
+			return gs.fromJSON(d["type"], value)
+		}
+	}
+	return gs.fromUntypedJSON(value)
+}
+
+// jsonMatches returns true when the JSON value can be of the type of the branch of a union
+func (gs *genericSchema) jsonMatches(branch, value interface{}) bool {
+	switch b := gs.named.resolve(branch).(type) {
+	case string:
+		switch b {
+		case "null":
+			return false
+		case "boolean":
+			_, ok := value.(bool)
+			return ok
+		case "int", "long", "float", "double":
+			_, ok := value.(json.Number)
+			return ok
+		case "string", "bytes":
+			_, ok := value.(string)
+			return ok
+		}
+	case map[string]interface{}:
+		switch b["type"] {
+		case "record", "error", "map":
+			_, ok := value.(map[string]interface{})
+			return ok
+		case "array":
+			_, ok := value.([]interface{})
+			return ok
+		case "enum", "fixed":
+			_, ok := value.(string)
+			return ok
+		default:
+			return gs.jsonMatches(b["type"], value)
+		}
+	}
+	return false
+}
+
+// fromUntypedJSON converts a JSON value without definition, objects become records with sorted fields
+func (gs *genericSchema) fromUntypedJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = gs.fromUntypedJSON(item)
+		}
+		return converted
+	case map[string]interface{}:
+		record := &GenericRecord{
+			schemaInfo: gs.info,
+			fields:     make([]string, 0, len(v)),
+			values:     make(map[string]interface{}, len(v)),
+		}
+		for name, fv := range v {
+			record.fields = append(record.fields, name)
+			record.values[name] = gs.fromUntypedJSON(fv)
+		}
+		sort.Strings(record.fields)
+		return record
+	}
+	return value
+}
+
+var errInvalidProtobuf = newError(InvalidMessage, "invalid protobuf encoding")
+
+// protobufField is a field value as found on the wire
+type protobufField struct {
+	wireType uint64
+	value    uint64
+	data     []byte
+}
+
+// fromProtobuf decodes a Protobuf message with the Avro definition of the schema. The field numbers are taken
+// from fieldNumbers when given, else the fields are numbered from 1 in the order of the definition.
+func (gs *genericSchema) fromProtobuf(definition map[string]interface{}, data []byte,
+	fieldNumbers map[string]uint64) (*GenericRecord, error) {
+	fields := make(map[uint64][]protobufField)
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errInvalidProtobuf
+		}
+		data = data[n:]
+
+		f := protobufField{wireType: key & 7}
+		switch f.wireType {
+		case proto.WireVarint:
+			f.value, n = binary.Uvarint(data)
+		case proto.WireFixed64:
+			if n = 8; len(data) >= n {
+				f.value = binary.LittleEndian.Uint64(data)
+			}
+		case proto.WireBytes:
+			var length uint64
+			if length, n = binary.Uvarint(data); n > 0 {
+				if uint64(len(data)-n) < length {
+					return nil, errInvalidProtobuf
+				}
+				f.data = data[n : n+int(length)]
+				n += int(length)
+			}
+		case proto.WireFixed32:
+			if n = 4; len(data) >= n {
+				f.value = uint64(binary.LittleEndian.Uint32(data))
+			}
+		default:
+			return nil, newError(InvalidMessage, fmt.Sprintf("unsupported protobuf wire type %d", f.wireType))
+		}
+		if n <= 0 || n > len(data) {
+			return nil, errInvalidProtobuf
+		}
+		data = data[n:]
+		fields[key>>3] = append(fields[key>>3], f)
+	}
+
+	var decodeErr error
+	position := uint64(0)
+	record := gs.newRecord(definition, func(name string, fieldType interface{}) interface{} {
+		position++
+		number := position
+		if n, ok := fieldNumbers[name]; ok {
+			number = n
+		}
+		value, err := gs.protobufValue(fieldType, fields[number])
+		if err != nil && decodeErr == nil {
+			decodeErr = err
+		}
+		return value
+	})
+	return record, decodeErr
+}
+
+// protobufValue converts the occurrences of a field, the last one wins except for repeated fields
+func (gs *genericSchema) protobufValue(definition interface{}, occurrences []protobufField) (interface{}, error) {
+	if len(occurrences) == 0 {
+		return nil, nil
+	}
+
+	switch d := gs.named.resolve(definition).(type) {
+	case []interface{}:
+		// an optional field: the union of null and the type of the field
+		for _, branch := range d {
+			if branch != "null" {
+				return gs.protobufValue(branch, occurrences)
+			}
+		}
+	case map[string]interface{}:
+		switch d["type"] {
+		case "array":
+			var values []interface{}
+			for _, f := range occurrences {
+				items, err := gs.protobufRepeated(d["items"], f)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, items...)
+			}
+			return values, nil
+		case "record", "error":
+			return gs.fromProtobuf(d, occurrences[len(occurrences)-1].data, nil)
+		case "enum":
+			symbols, _ := d["symbols"].([]interface{})
+			if i := occurrences[len(occurrences)-1].value; i < uint64(len(symbols)) {
+				return symbols[i], nil
+			}
+			return nil, nil
+		default:
+			return gs.protobufValue(d["type"], occurrences)
+		}
+	case string:
+		return protobufScalar(d, occurrences[len(occurrences)-1]), nil
+	}
+	return nil, nil
+}
+
+// protobufRepeated converts an occurrence of a repeated field, packed or not
+func (gs *genericSchema) protobufRepeated(items interface{}, f protobufField) ([]interface{}, error) {
+	itemType, scalar := gs.named.resolve(items).(string)
+	if !scalar || f.wireType != proto.WireBytes || itemType == "string" || itemType == "bytes" {
+		value, err := gs.protobufValue(items, []protobufField{f})
+		return []interface{}{value}, err
+	}
+
+	// packed scalars
+	var values []interface{}
+	data := f.data
+	for len(data) > 0 {
+		item := protobufField{wireType: proto.WireVarint}
+		n := 0
+		switch itemType {
+		case "float":
+			item.wireType = proto.WireFixed32
+			if n = 4; len(data) >= n {
+				item.value = uint64(binary.LittleEndian.Uint32(data))
+			}
+		case "double":
+			item.wireType = proto.WireFixed64
+			if n = 8; len(data) >= n {
+				item.value = binary.LittleEndian.Uint64(data)
+			}
+		default:
+			item.value, n = binary.Uvarint(data)
+		}
+		if n <= 0 || n > len(data) {
+			return nil, errInvalidProtobuf
+		}
+		data = data[n:]
+		values = append(values, protobufScalar(itemType, item))
+	}
+	return values, nil
+}
+
+func protobufScalar(avroType string, f protobufField) interface{} {
+	switch avroType {
+	case "boolean":
+		return f.value != 0
+	case "int":
+		return int32(f.value)
+	case "long":
+		return int64(f.value)
+	case "float":
+		return math.Float32frombits(uint32(f.value))
+	case "double":
+		return math.Float64frombits(f.value)
+	case "string":
+		return string(f.data)
+	case "bytes":
+		return f.data
+	}
+	if f.wireType == proto.WireBytes {
+		return f.data
+	}
+	return f.value
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/integration-tests/pb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const nestedSchemaDef = `{"type":"record","name":"Person","namespace":"test","fields":[` +
+	`{"name":"name","type":"string"},` +
+	`{"name":"age","type":["null","int"],"default":null},` +
+	`{"name":"address","type":{"type":"record","name":"Address","fields":[{"name":"city","type":"string"}]}},` +
+	`{"name":"previous","type":{"type":"array","items":"Address"}},` +
+	`{"name":"score","type":"double"}]}`
+
+func TestGenericRecordAvro(t *testing.T) {
+	gs, err := newGenericSchema(&SchemaInfo{Type: AVRO, Schema: nestedSchemaDef})
+	assert.Nil(t, err)
+
+	data, err := NewAvroSchema(nestedSchemaDef, nil).Encode(map[string]interface{}{
+		"name":     "alice",
+		"age":      map[string]interface{}{"int": 30},
+		"address":  map[string]interface{}{"city": "Paris"},
+		"previous": []interface{}{map[string]interface{}{"city": "Lyon"}},
+		"score":    1.5,
+	})
+	assert.Nil(t, err)
+
+	record, err := gs.decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, AVRO, record.SchemaType())
+	assert.Equal(t, []string{"name", "age", "address", "previous", "score"}, record.Fields())
+	assert.Equal(t, "alice", record.Field("name"))
+	assert.Equal(t, int32(30), record.Field("age"))
+	assert.Equal(t, 1.5, record.Field("score"))
+	assert.Equal(t, "Paris", record.Field("address").(*GenericRecord).Field("city"))
+	previous := record.Field("previous").([]interface{})
+	assert.Equal(t, "Lyon", previous[0].(*GenericRecord).Field("city"))
+	assert.Nil(t, record.Field("unknown"))
+}
+
+func TestGenericRecordJSON(t *testing.T) {
+	gs, err := newGenericSchema(&SchemaInfo{Type: JSON, Schema: nestedSchemaDef})
+	assert.Nil(t, err)
+
+	record, err := gs.decode([]byte(`{"score":2,"name":"bob","age":40,"address":{"city":"Rome"},` +
+		`"previous":[{"city":"Milan"}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name", "age", "address", "previous", "score"}, record.Fields())
+	assert.Equal(t, "bob", record.Field("name"))
+	assert.Equal(t, int64(40), record.Field("age"))
+	assert.Equal(t, float64(2), record.Field("score"))
+	assert.Equal(t, "Rome", record.Field("address").(*GenericRecord).Field("city"))
+	previous := record.Field("previous").([]interface{})
+	assert.Equal(t, "Milan", previous[0].(*GenericRecord).Field("city"))
+
+	// without definition the fields are sorted
+	gs, err = newGenericSchema(&SchemaInfo{Type: JSON})
+	assert.Nil(t, err)
+	record, err = gs.decode([]byte(`{"b":1.5,"a":{"c":1}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, record.Fields())
+	assert.Equal(t, 1.5, record.Field("b"))
+	assert.Equal(t, int64(1), record.Field("a").(*GenericRecord).Field("c"))
+}
+
+func TestGenericRecordProtobuf(t *testing.T) {
+	data, err := proto.Marshal(&pb.Test{Num: 100, Msf: "pulsar"})
+	assert.Nil(t, err)
+
+	gs, err := newGenericSchema(&SchemaInfo{Type: PROTOBUF, Schema: protoSchemaDef})
+	assert.Nil(t, err)
+	record, err := gs.decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"num", "msf"}, record.Fields())
+	assert.Equal(t, int32(100), record.Field("num"))
+	assert.Equal(t, "pulsar", record.Field("msf"))
+
+	// the field numbers given by the Java client take precedence over the order of the fields
+	reversedDef := `{"type":"record","name":"Example","namespace":"test",` +
+		`"fields":[{"name":"msf","type":"string"},{"name":"num","type":"int"}]}`
+	gs, err = newGenericSchema(&SchemaInfo{
+		Type:   PROTOBUF,
+		Schema: reversedDef,
+		Properties: map[string]string{
+			protobufParsingInfo: `[{"number":2,"name":"msf"},{"number":1,"name":"num"}]`,
+		},
+	})
+	assert.Nil(t, err)
+	record, err = gs.decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"msf", "num"}, record.Fields())
+	assert.Equal(t, int32(100), record.Field("num"))
+	assert.Equal(t, "pulsar", record.Field("msf"))
+
+	_, err = gs.decode([]byte{0x0a, 0x10})
+	assert.NotNil(t, err)
+}
+
+func TestGenericSchemaUnsupportedType(t *testing.T) {
+	_, err := newGenericSchema(&SchemaInfo{Type: STRING})
+	assert.NotNil(t, err)
+}
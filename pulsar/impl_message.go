@@ -233,6 +233,8 @@ type message struct {
 	replicatedFrom      string
 	redeliveryCount     uint32
 	schema              Schema
+	schemaVersion       []byte
+	schemaInfoCache     *schemaInfoCache
 	encryptionContext   *EncryptionContext
 }
 
@@ -281,9 +283,16 @@ func (msg *message) GetReplicatedFrom() string {
 }
 
 func (msg *message) GetSchemaValue(v interface{}) error {
+	if md, ok := msg.schema.(messageDecoder); ok {
+		return md.decodeMessage(msg, v)
+	}
 	return msg.schema.Decode(msg.payLoad, v)
 }
 
+func (msg *message) SchemaVersion() []byte {
+	return msg.schemaVersion
+}
+
 func (msg *message) ProducerName() string {
 	return msg.producerName
 }
@@ -292,6 +301,21 @@ func (msg *message) GetEncryptionContext() *EncryptionContext {
 	return msg.encryptionContext
 }
 
+// override returns a copy of the message carrying the payload, keys, properties and event time of m.
+// The ID and the delivery metadata of the original message are kept so that it can still be acknowledged.
+func (msg *message) override(m Message) *message {
+	if m == nil || m == Message(msg) {
+		return msg
+	}
+	overridden := *msg
+	overridden.payLoad = m.Payload()
+	overridden.key = m.Key()
+	overridden.orderingKey = m.OrderingKey()
+	overridden.properties = m.Properties()
+	overridden.eventTime = m.EventTime()
+	return &overridden
+}
+
 func newAckTracker(size int) *ackTracker {
 	var batchIDs *big.Int
 	if size <= 64 {
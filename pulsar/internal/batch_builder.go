@@ -18,6 +18,7 @@
 package internal
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -48,7 +49,7 @@ type BatchBuilder interface {
 	Add(
 		metadata *pb.SingleMessageMetadata, sequenceIDGenerator *uint64,
 		payload []byte,
-		callback interface{}, replicateTo []string, deliverAt time.Time,
+		callback interface{}, replicateTo []string, deliverAt time.Time, schemaVersion []byte,
 	) bool
 
 	// Flush all the messages buffered in the client and wait until all messages have been successfully persisted.
@@ -164,7 +165,7 @@ func (bc *batchContainer) hasSpace(payload []byte) bool {
 func (bc *batchContainer) Add(
 	metadata *pb.SingleMessageMetadata, sequenceIDGenerator *uint64,
 	payload []byte,
-	callback interface{}, replicateTo []string, deliverAt time.Time,
+	callback interface{}, replicateTo []string, deliverAt time.Time, schemaVersion []byte,
 ) bool {
 	if replicateTo != nil && bc.numMessages != 0 {
 		// If the current batch is not empty and we're trying to set the replication clusters,
@@ -177,6 +178,10 @@ func (bc *batchContainer) Add(
 	} else if bc.hasSpace(payload) {
 		// The current batch is full. Producer has to call Flush() to
 		return false
+	} else if bc.numMessages != 0 && !bytes.Equal(bc.msgMetadata.SchemaVersion, schemaVersion) {
+		// The messages of a batch share the schema version, need to flush before the next
+		// message can be sent
+		return false
 	}
 
 	if bc.numMessages == 0 {
@@ -191,6 +196,8 @@ func (bc *batchContainer) Add(
 		bc.msgMetadata.ProducerName = &bc.producerName
 		bc.msgMetadata.ReplicateTo = replicateTo
 		bc.msgMetadata.PartitionKey = metadata.PartitionKey
+		bc.msgMetadata.PartitionKeyB64Encoded = metadata.PartitionKeyB64Encoded
+		bc.msgMetadata.SchemaVersion = schemaVersion
 
 		if deliverAt.UnixNano() > 0 {
 			bc.msgMetadata.DeliverAtTime = proto.Int64(int64(TimestampMillis(deliverAt)))
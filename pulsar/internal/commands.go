@@ -48,6 +48,16 @@ var ErrEOM = errors.New("EOF")
 
 var ErrConnectionClosed = errors.New("connection closed")
 
+// ServerError is the error returned by a request the broker failed
+type ServerError struct {
+	Code    pb.ServerError
+	Message string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("server error: %s: %s", e.Code, e.Message)
+}
+
 func NewMessageReader(headersAndPayload Buffer) *MessageReader {
 	return &MessageReader{
 		buffer: headersAndPayload,
@@ -197,6 +207,12 @@ func baseCommand(cmdType pb.BaseCommand_Type, msg proto.Message) *pb.BaseCommand
 		cmd.GetLastMessageId = msg.(*pb.CommandGetLastMessageId)
 	case pb.BaseCommand_AUTH_RESPONSE:
 		cmd.AuthResponse = msg.(*pb.CommandAuthResponse)
+	case pb.BaseCommand_GET_SCHEMA:
+		cmd.GetSchema = msg.(*pb.CommandGetSchema)
+	case pb.BaseCommand_WATCH_TOPIC_LIST:
+		cmd.WatchTopicList = msg.(*pb.CommandWatchTopicList)
+	case pb.BaseCommand_WATCH_TOPIC_LIST_CLOSE:
+		cmd.WatchTopicListClose = msg.(*pb.CommandWatchTopicListClose)
 	default:
 		panic(fmt.Sprintf("Missing command type: %v", cmdType))
 	}
@@ -76,8 +76,11 @@ type Connection interface {
 	UnregisterListener(id uint64)
 	AddConsumeHandler(id uint64, handler ConsumerHandler)
 	DeleteConsumeHandler(id uint64)
+	AddTopicListWatcher(id uint64, handler TopicListWatcherHandler)
+	DeleteTopicListWatcher(id uint64)
 	ID() string
 	GetMaxMessageSize() int32
+	IsTopicWatcherSupported() bool
 	Close()
 }
 
@@ -88,6 +91,16 @@ type ConsumerHandler interface {
 	ConnectionClosed()
 }
 
+// TopicListWatcherHandler is a topic list watcher registered on a connection
+// to receive the topic changes pushed by the broker.
+type TopicListWatcherHandler interface {
+	// TopicListUpdated process the topics added to and removed from the watched namespace.
+	TopicListUpdated(update *pb.CommandWatchTopicUpdate)
+
+	// ConnectionClosed close the TCP connection.
+	ConnectionClosed()
+}
+
 type connectionState int32
 
 const (
@@ -163,11 +176,15 @@ type connection struct {
 	consumerHandlersLock sync.RWMutex
 	consumerHandlers     map[uint64]ConsumerHandler
 
+	topicListWatchersLock sync.RWMutex
+	topicListWatchers     map[uint64]TopicListWatcherHandler
+
 	tlsOptions *TLSOptions
 	auth       auth.Provider
 
-	maxMessageSize int32
-	metrics        *Metrics
+	maxMessageSize        int32
+	topicWatcherSupported bool
+	metrics               *Metrics
 }
 
 // connectionOptions defines configurations for creating connection.
@@ -202,10 +219,11 @@ func newConnection(opts connectionOptions) *connection {
 		// partition produces writing on a single connection. In general it's
 		// good to keep this above the number of partition producers assigned
 		// to a single connection.
-		writeRequestsCh:  make(chan Buffer, 256),
-		listeners:        make(map[uint64]ConnectionListener),
-		consumerHandlers: make(map[uint64]ConsumerHandler),
-		metrics:          opts.metrics,
+		writeRequestsCh:   make(chan Buffer, 256),
+		listeners:         make(map[uint64]ConnectionListener),
+		consumerHandlers:  make(map[uint64]ConsumerHandler),
+		topicListWatchers: make(map[uint64]TopicListWatcherHandler),
+		metrics:           opts.metrics,
 	}
 	cnx.setState(connectionInit)
 	cnx.reader = newConnectionReader(cnx)
@@ -321,6 +339,7 @@ func (c *connection) doHandshake() bool {
 		c.log.Debug("No MaxMessageSize from handshake response, use default: ", MaxMessageSize)
 		c.maxMessageSize = MaxMessageSize
 	}
+	c.topicWatcherSupported = cmd.Connected.GetFeatureFlags().GetSupportsTopicWatchers()
 	c.log.Info("Connection is ready")
 	c.changeState(connectionReady)
 	return true
@@ -515,7 +534,13 @@ func (c *connection) internalReceivedCommand(cmd *pb.BaseCommand, headersAndPayl
 		c.handleResponse(cmd.Success.GetRequestId(), cmd)
 
 	case pb.BaseCommand_PRODUCER_SUCCESS:
-		c.handleResponse(cmd.ProducerSuccess.GetRequestId(), cmd)
+		if !cmd.ProducerSuccess.GetProducerReady() {
+			// the producer waits for the exclusive access to the topic, the broker sends a second
+			// success once it is granted
+			c.handleResponseInProgress(cmd.ProducerSuccess.GetRequestId(), cmd)
+		} else {
+			c.handleResponse(cmd.ProducerSuccess.GetRequestId(), cmd)
+		}
 
 	case pb.BaseCommand_PARTITIONED_METADATA_RESPONSE:
 		c.handleResponse(cmd.PartitionMetadataResponse.GetRequestId(), cmd)
@@ -536,6 +561,12 @@ func (c *connection) internalReceivedCommand(cmd *pb.BaseCommand, headersAndPayl
 	case pb.BaseCommand_GET_SCHEMA_RESPONSE:
 		c.handleResponse(cmd.GetSchemaResponse.GetRequestId(), cmd)
 
+	case pb.BaseCommand_WATCH_TOPIC_LIST_SUCCESS:
+		c.handleResponse(cmd.WatchTopicListSuccess.GetRequestId(), cmd)
+
+	case pb.BaseCommand_WATCH_TOPIC_UPDATE:
+		c.handleWatchTopicUpdate(cmd.GetWatchTopicUpdate())
+
 	case pb.BaseCommand_ERROR:
 		c.handleResponseError(cmd.GetError())
 
@@ -645,6 +676,20 @@ func (c *connection) handleResponse(requestID uint64, response *pb.BaseCommand)
 	request.callback(response, nil)
 }
 
+// handleResponseInProgress passes an intermediate response to the request, which stays pending until the
+// final response
+func (c *connection) handleResponseInProgress(requestID uint64, response *pb.BaseCommand) {
+	c.pendingLock.Lock()
+	request, ok := c.pendingReqs[requestID]
+	c.pendingLock.Unlock()
+	if !ok {
+		c.log.Warnf("Received unexpected response for request %d of type %s", requestID, response.Type)
+		return
+	}
+
+	request.callback(response, nil)
+}
+
 func (c *connection) handleResponseError(serverError *pb.CommandError) {
 	requestID := serverError.GetRequestId()
 
@@ -655,8 +700,7 @@ func (c *connection) handleResponseError(serverError *pb.CommandError) {
 		return
 	}
 
-	errMsg := fmt.Sprintf("server error: %s: %s", serverError.GetError(), serverError.GetMessage())
-	request.callback(nil, errors.New(errMsg))
+	request.callback(nil, &ServerError{Code: serverError.GetError(), Message: serverError.GetMessage()})
 }
 
 func (c *connection) handleSendReceipt(response *pb.CommandSendReceipt) {
@@ -691,6 +735,15 @@ func (c *connection) handleMessage(response *pb.CommandMessage, payload Buffer)
 	}
 }
 
+func (c *connection) handleWatchTopicUpdate(update *pb.CommandWatchTopicUpdate) {
+	watcherID := update.GetWatcherId()
+	if watcher, ok := c.topicListWatcher(watcherID); ok {
+		watcher.TopicListUpdated(update)
+	} else {
+		c.log.WithField("watcherID", watcherID).Warn("Got unexpected topic list update")
+	}
+}
+
 func (c *connection) deletePendingRequest(requestID uint64) (*request, bool) {
 	c.pendingLock.Lock()
 	defer c.pendingLock.Unlock()
@@ -879,6 +932,14 @@ func (c *connection) Close() {
 		}
 		c.consumerHandlersLock.Unlock()
 
+		topicListWatchers := make(map[uint64]TopicListWatcherHandler)
+		c.topicListWatchersLock.Lock()
+		for id, watcher := range c.topicListWatchers {
+			topicListWatchers[id] = watcher
+			delete(c.topicListWatchers, id)
+		}
+		c.topicListWatchersLock.Unlock()
+
 		// notify producers connection closed
 		for _, listener := range listeners {
 			listener.ConnectionClosed()
@@ -889,6 +950,11 @@ func (c *connection) Close() {
 			handler.ConnectionClosed()
 		}
 
+		// notify topic list watchers connection closed
+		for _, watcher := range topicListWatchers {
+			watcher.ConnectionClosed()
+		}
+
 		c.metrics.ConnectionsClosed.Inc()
 	})
 }
@@ -973,6 +1039,37 @@ func (c *connection) consumerHandler(id uint64) (ConsumerHandler, bool) {
 	return h, ok
 }
 
+func (c *connection) AddTopicListWatcher(id uint64, handler TopicListWatcherHandler) {
+	// do not add if connection is closed
+	if c.closed() {
+		c.log.Warnf("Closed connection unable add topic list watcher with id=%+v", id)
+		return
+	}
+
+	c.topicListWatchersLock.Lock()
+	defer c.topicListWatchersLock.Unlock()
+	c.topicListWatchers[id] = handler
+}
+
+func (c *connection) DeleteTopicListWatcher(id uint64) {
+	c.topicListWatchersLock.Lock()
+	defer c.topicListWatchersLock.Unlock()
+	delete(c.topicListWatchers, id)
+}
+
+func (c *connection) topicListWatcher(id uint64) (TopicListWatcherHandler, bool) {
+	c.topicListWatchersLock.RLock()
+	defer c.topicListWatchersLock.RUnlock()
+	w, ok := c.topicListWatchers[id]
+	return w, ok
+}
+
+// IsTopicWatcherSupported reports whether the broker advertised support for
+// the WATCH_TOPIC_LIST command during the handshake.
+func (c *connection) IsTopicWatcherSupported() bool {
+	return c.topicWatcherSupported
+}
+
 func (c *connection) ID() string {
 	return fmt.Sprintf("%s -> %s", c.cnx.LocalAddr(), c.cnx.RemoteAddr())
 }
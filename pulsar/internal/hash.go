@@ -17,7 +17,14 @@
 
 package internal
 
-import "github.com/spaolacci/murmur3"
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash/crc32"
+	"sort"
+
+	"github.com/spaolacci/murmur3"
+)
 
 // JavaStringHash and Java String.hashCode() equivalent
 func JavaStringHash(s string) uint32 {
@@ -39,3 +46,21 @@ func Murmur3_32Hash(s string) uint32 {
 	// Maintain compatibility with values used in Java client
 	return h.Sum32() & 0x7fffffff
 }
+
+// TopicListHash computes the hash of a topic list the same way the broker does when
+// watching the topics of a namespace: a crc32c over the sorted topic names, rendered
+// as the hex string of its little-endian bytes.
+func TopicListHash(topics []string) string {
+	sorted := make([]string, len(topics))
+	copy(sorted, topics)
+	sort.Strings(sorted)
+
+	h := crc32.New(crc32cTable)
+	for _, topic := range sorted {
+		_, _ = h.Write([]byte(topic))
+	}
+
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, h.Sum32())
+	return hex.EncodeToString(b)
+}
@@ -58,3 +58,21 @@ func TestMurmurHash(t *testing.T) {
 		})
 	}
 }
+
+func TestTopicListHash(t *testing.T) {
+	assert.Equal(t, "00000000", TopicListHash(nil))
+	assert.Equal(t, "3043d0c1", TopicListHash([]string{"a"}))
+
+	topics := []string{
+		"persistent://public/default/topic-b",
+		"persistent://public/default/topic-a",
+	}
+	sorted := []string{
+		"persistent://public/default/topic-a",
+		"persistent://public/default/topic-b",
+	}
+	assert.Equal(t, TopicListHash(sorted), TopicListHash(topics))
+	// the input slice is left untouched
+	assert.Equal(t, "persistent://public/default/topic-b", topics[0])
+	assert.NotEqual(t, TopicListHash(sorted[:1]), TopicListHash(sorted))
+}
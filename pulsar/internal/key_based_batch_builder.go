@@ -18,6 +18,7 @@
 package internal
 
 import (
+	"bytes"
 	"encoding/base64"
 	"sort"
 	"sync"
@@ -124,7 +125,7 @@ func (bc *keyBasedBatchContainer) hasSpace(payload []byte) bool {
 func (bc *keyBasedBatchContainer) Add(
 	metadata *pb.SingleMessageMetadata, sequenceIDGenerator *uint64,
 	payload []byte,
-	callback interface{}, replicateTo []string, deliverAt time.Time,
+	callback interface{}, replicateTo []string, deliverAt time.Time, schemaVersion []byte,
 ) bool {
 	if replicateTo != nil && bc.numMessages != 0 {
 		// If the current batch is not empty and we're trying to set the replication clusters,
@@ -137,6 +138,14 @@ func (bc *keyBasedBatchContainer) Add(
 	} else if bc.hasSpace(payload) {
 		// The current batch is full. Producer has to call Flush() to
 		return false
+	} else if bc.numMessages != 0 && !bytes.Equal(bc.msgMetadata.SchemaVersion, schemaVersion) {
+		// The messages of a batch share the schema version, need to flush before the next
+		// message can be sent
+		return false
+	}
+
+	if bc.numMessages == 0 {
+		bc.msgMetadata.SchemaVersion = schemaVersion
 	}
 
 	var msgKey = getMessageKey(metadata)
@@ -154,7 +163,7 @@ func (bc *keyBasedBatchContainer) Add(
 	// add message to batch container
 	batchPart.Add(
 		metadata, sequenceIDGenerator, payload, callback, replicateTo,
-		deliverAt,
+		deliverAt, schemaVersion,
 	)
 	addSingleMessageToBatch(bc.buffer, metadata, payload)
 
@@ -18,6 +18,7 @@
 package internal
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net/url"
@@ -39,8 +40,8 @@ type GetTopicsOfNamespaceMode string
 
 const (
 	Persistent    GetTopicsOfNamespaceMode = "PERSISTENT"
-	NonPersistent                          = "NON_PERSISTENT"
-	All                                    = "ALL"
+	NonPersistent GetTopicsOfNamespaceMode = "NON_PERSISTENT"
+	All           GetTopicsOfNamespaceMode = "ALL"
 )
 
 // PartitionedTopicMetadata encapsulates a struct for metadata of a partitioned topic
@@ -61,6 +62,10 @@ type LookupService interface {
 	// GetTopicsOfNamespace returns all the topics name for a given namespace.
 	GetTopicsOfNamespace(namespace string, mode GetTopicsOfNamespaceMode) ([]string, error)
 
+	// GetSchema returns the schema of the topic with the given version, or the latest schema
+	// when the version is nil. The schema is nil when the topic has no schema.
+	GetSchema(topic string, schemaVersion []byte) (*pb.Schema, error)
+
 	// Closable Allow Lookup Service's internal client to be able to closed
 	Closable
 }
@@ -240,6 +245,32 @@ func (ls *lookupService) GetTopicsOfNamespace(namespace string, mode GetTopicsOf
 	return res.Response.GetTopicsOfNamespaceResponse.GetTopics(), nil
 }
 
+func (ls *lookupService) GetSchema(topic string, schemaVersion []byte) (*pb.Schema, error) {
+	lr, err := ls.Lookup(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	id := ls.rpcClient.NewRequestID()
+	req := &pb.CommandGetSchema{
+		RequestId:     proto.Uint64(id),
+		Topic:         proto.String(topic),
+		SchemaVersion: schemaVersion,
+	}
+	res, err := ls.rpcClient.Request(lr.LogicalAddr, lr.PhysicalAddr, id, pb.BaseCommand_GET_SCHEMA, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.GetSchemaResponse.GetErrorCode() == pb.ServerError_TopicNotFound {
+		return nil, nil
+	} else if res.Response.GetSchemaResponse.ErrorCode != nil {
+		return nil, fmt.Errorf("no schema for topic{%s}: %s: %s", topic,
+			res.Response.GetSchemaResponse.GetErrorCode(), res.Response.GetSchemaResponse.GetErrorMessage())
+	}
+
+	return res.Response.GetSchemaResponse.GetSchema(), nil
+}
+
 func (ls *lookupService) Close() {}
 
 const HTTPLookupServiceBasePathV1 string = "/lookup/v2/destination/"
@@ -248,6 +279,13 @@ const HTTPAdminServiceV1Format string = "/admin/%s/partitions"
 const HTTPAdminServiceV2Format string = "/admin/v2/%s/partitions"
 const HTTPTopicUnderNamespaceV1 string = "/admin/namespaces/%s/destinations?mode=%s"
 const HTTPTopicUnderNamespaceV2 string = "/admin/v2/namespaces/%s/topics?mode=%s"
+const HTTPSchemaV2Format string = "/admin/v2/schemas/%s/%s/schema"
+
+type httpSchema struct {
+	Type       string            `json:"type"`
+	Data       string            `json:"data"`
+	Properties map[string]string `json:"properties"`
+}
 
 type httpLookupData struct {
 	BrokerURL    string `json:"brokerUrl"`
@@ -358,6 +396,69 @@ func (h *httpLookupService) GetTopicsOfNamespace(namespace string, mode GetTopic
 	return topics, nil
 }
 
+func (h *httpLookupService) GetSchema(topic string, schemaVersion []byte) (*pb.Schema, error) {
+	topicName, err := ParseTopicName(topic)
+	if err != nil {
+		return nil, err
+	}
+	// the partitions share the schema of the partitioned topic
+	topicName, err = ParseTopicName(TopicNameWithoutPartitionPart(topicName))
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(HTTPSchemaV2Format, topicName.Namespace, url.QueryEscape(topicName.Topic))
+	if len(schemaVersion) == 8 {
+		// the schema versions of the broker are big endian int64
+		path = fmt.Sprintf("%s/%d", path, binary.BigEndian.Uint64(schemaVersion))
+	}
+
+	schema := &httpSchema{}
+	err = h.httpClient.Get(path, schema, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	h.log.Debugf("Got topic{%s} schema response: %+v", topic, schema)
+
+	schemaType, ok := pb.Schema_Type_value[httpSchemaTypes[schema.Type]]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema type %s for topic{%s}", schema.Type, topic)
+	}
+	pbType := pb.Schema_Type(schemaType)
+	return &pb.Schema{
+		Name:       proto.String(topicName.Topic),
+		Type:       &pbType,
+		SchemaData: []byte(schema.Data),
+		Properties: ConvertFromStringMap(schema.Properties),
+	}, nil
+}
+
+// httpSchemaTypes maps the schema types of the REST API to the names of the binary protocol
+var httpSchemaTypes = map[string]string{
+	"NONE":            "None",
+	"STRING":          "String",
+	"JSON":            "Json",
+	"PROTOBUF":        "Protobuf",
+	"AVRO":            "Avro",
+	"BOOLEAN":         "Bool",
+	"INT8":            "Int8",
+	"INT16":           "Int16",
+	"INT32":           "Int32",
+	"INT64":           "Int64",
+	"FLOAT":           "Float",
+	"DOUBLE":          "Double",
+	"DATE":            "Date",
+	"TIME":            "Time",
+	"TIMESTAMP":       "Timestamp",
+	"KEY_VALUE":       "KeyValue",
+	"INSTANT":         "Instant",
+	"LOCAL_DATE":      "LocalDate",
+	"LOCAL_TIME":      "LocalTime",
+	"LOCAL_DATE_TIME": "LocalDateTime",
+	"PROTOBUF_NATIVE": "ProtobufNative",
+}
+
 func (h *httpLookupService) Close() {
 	h.httpClient.Close()
 }
@@ -537,7 +537,10 @@ type MockHTTPClient struct {
 func (c *MockHTTPClient) Close() {}
 
 func (c *MockHTTPClient) Get(endpoint string, obj interface{}, params map[string]string) error {
-	if strings.Contains(endpoint, HTTPLookupServiceBasePathV1) || strings.Contains(endpoint,
+	if endpoint == "/admin/v2/schemas/public/default/my-topic/schema" ||
+		endpoint == "/admin/v2/schemas/public/default/my-topic/schema/3" {
+		return mockHTTPGetSchemaResult(obj)
+	} else if strings.Contains(endpoint, HTTPLookupServiceBasePathV1) || strings.Contains(endpoint,
 		HTTPLookupServiceBasePathV2) {
 		return mockHTTPGetLookupResult(obj)
 	} else if strings.Contains(endpoint, "partitions") {
@@ -569,6 +572,17 @@ func mockHTTPGetPartitionedTopicMetadataResult(obj interface{}) error {
 	return err
 }
 
+func mockHTTPGetSchemaResult(obj interface{}) error {
+	jsonResponse := `{
+		"version": 3,
+		"type": "AVRO",
+		"timestamp": 0,
+		"data": "{\"type\":\"string\"}",
+		"properties": {"a": "b"}
+	}`
+	return json.Unmarshal([]byte(jsonResponse), obj)
+}
+
 func NewMockHTTPClient(serviceNameResolver ServiceNameResolver) HTTPClient {
 	h := &MockHTTPClient{}
 	h.ServiceNameResolver = serviceNameResolver
@@ -605,3 +619,23 @@ func TestHttpGetPartitionedTopicMetadataSuccess(t *testing.T) {
 
 	assert.Equal(t, 1, tMetadata.Partitions)
 }
+
+func TestHttpGetSchemaSuccess(t *testing.T) {
+	url, err := url.Parse("http://broker-1:8080")
+	assert.NoError(t, err)
+	serviceNameResolver := NewPulsarServiceNameResolver(url)
+	httpClient := NewMockHTTPClient(serviceNameResolver)
+	ls := NewHTTPLookupService(httpClient, url, serviceNameResolver, false,
+		log.DefaultNopLogger(), NewMetricsProvider(4, map[string]string{}))
+
+	schema, err := ls.GetSchema("my-topic", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.Schema_Avro, schema.GetType())
+	assert.Equal(t, `{"type":"string"}`, string(schema.GetSchemaData()))
+	assert.Equal(t, "a", schema.GetProperties()[0].GetKey())
+
+	// the partitions share the schema of the topic
+	schema, err = ls.GetSchema("persistent://public/default/my-topic-partition-1", []byte{0, 0, 0, 0, 0, 0, 0, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.Schema_Avro, schema.GetType())
+}
@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type MemoryLimitController interface {
+	// ReserveMemory reserves size bytes, blocking until enough memory is released or the context expires.
+	// It returns false if the context expired.
+	ReserveMemory(ctx context.Context, size int64) bool
+
+	// TryReserveMemory reserves size bytes if the limit allows it and returns immediately
+	// with `true` if the memory was reserved and `false` otherwise.
+	TryReserveMemory(size int64) bool
+
+	// ReleaseMemory returns size bytes to the controller, unblocking the callers waiting for memory.
+	ReleaseMemory(size int64)
+
+	// CurrentUsage returns the number of bytes reserved.
+	CurrentUsage() int64
+}
+
+type memoryLimitController struct {
+	limit        int64
+	currentUsage int64
+	// waiting is the number of callers blocked in ReserveMemory
+	waiting int32
+	// released is closed and replaced when memory is released while callers are waiting, to wake them up
+	released chan struct{}
+	lock     sync.Mutex
+	usage    prometheus.Gauge
+}
+
+// NewMemoryLimitController creates a controller reserving at most limit bytes, a limit <= 0 disables it.
+// usage, when not nil, tracks the bytes reserved.
+func NewMemoryLimitController(limit int64, usage prometheus.Gauge) MemoryLimitController {
+	return &memoryLimitController{
+		limit:    limit,
+		released: make(chan struct{}),
+		usage:    usage,
+	}
+}
+
+func (m *memoryLimitController) ReserveMemory(ctx context.Context, size int64) bool {
+	if m.tryReserve(size) {
+		return true
+	}
+
+	for {
+		m.lock.Lock()
+		atomic.AddInt32(&m.waiting, 1)
+		// the memory may have been released before the caller was counted as waiting
+		if m.tryReserve(size) {
+			atomic.AddInt32(&m.waiting, -1)
+			m.lock.Unlock()
+			return true
+		}
+		released := m.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+			atomic.AddInt32(&m.waiting, -1)
+		case <-ctx.Done():
+			atomic.AddInt32(&m.waiting, -1)
+			return false
+		}
+	}
+}
+
+func (m *memoryLimitController) TryReserveMemory(size int64) bool {
+	return m.tryReserve(size)
+}
+
+func (m *memoryLimitController) tryReserve(size int64) bool {
+	if m.limit <= 0 {
+		atomic.AddInt64(&m.currentUsage, size)
+	} else {
+		for {
+			current := atomic.LoadInt64(&m.currentUsage)
+			// a payload larger than the limit is accepted alone, otherwise it would wait forever
+			if current > 0 && current+size > m.limit {
+				return false
+			}
+			if atomic.CompareAndSwapInt64(&m.currentUsage, current, current+size) {
+				break
+			}
+		}
+	}
+
+	if m.usage != nil {
+		m.usage.Add(float64(size))
+	}
+	return true
+}
+
+func (m *memoryLimitController) ReleaseMemory(size int64) {
+	atomic.AddInt64(&m.currentUsage, -size)
+	if m.usage != nil {
+		m.usage.Sub(float64(size))
+	}
+
+	// the callers are only woken up when some are waiting for memory
+	if m.limit <= 0 || atomic.LoadInt32(&m.waiting) == 0 {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	close(m.released)
+	m.released = make(chan struct{})
+}
+
+func (m *memoryLimitController) CurrentUsage() int64 {
+	return atomic.LoadInt64(&m.currentUsage)
+}
@@ -0,0 +1,125 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLimitController(t *testing.T) {
+	m := NewMemoryLimitController(100, nil)
+
+	const n = 10
+
+	wg := sync.WaitGroup{}
+	wg.Add(n)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			assert.True(t, m.ReserveMemory(context.Background(), 30))
+			time.Sleep(50 * time.Millisecond)
+			assert.True(t, m.CurrentUsage() <= 100)
+			m.ReleaseMemory(30)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int64(0), m.CurrentUsage())
+}
+
+func TestMemoryLimitController_NoLostWakeUp(t *testing.T) {
+	m := NewMemoryLimitController(100, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const n = 20
+
+	wg := sync.WaitGroup{}
+	wg.Add(n)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if !assert.True(t, m.ReserveMemory(ctx, 40)) {
+					return
+				}
+				m.ReleaseMemory(40)
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int64(0), m.CurrentUsage())
+}
+
+func TestMemoryLimitController_TryReserveMemory(t *testing.T) {
+	m := NewMemoryLimitController(100, nil)
+
+	assert.True(t, m.TryReserveMemory(60))
+	assert.False(t, m.TryReserveMemory(50))
+	assert.True(t, m.TryReserveMemory(40))
+	assert.Equal(t, int64(100), m.CurrentUsage())
+
+	m.ReleaseMemory(100)
+
+	// a payload larger than the limit is accepted when nothing else is reserved
+	assert.True(t, m.TryReserveMemory(150))
+	assert.False(t, m.TryReserveMemory(1))
+	m.ReleaseMemory(150)
+}
+
+func TestMemoryLimitController_NoLimit(t *testing.T) {
+	m := NewMemoryLimitController(0, nil)
+
+	assert.True(t, m.TryReserveMemory(1<<40))
+	assert.True(t, m.TryReserveMemory(1<<40))
+	assert.Equal(t, int64(1<<41), m.CurrentUsage())
+}
+
+func TestMemoryLimitController_ContextExpire(t *testing.T) {
+	m := NewMemoryLimitController(100, nil)
+
+	assert.True(t, m.ReserveMemory(context.Background(), 100))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.False(t, m.ReserveMemory(ctx, 10))
+
+	m.ReleaseMemory(100)
+	assert.True(t, m.ReserveMemory(context.Background(), 10))
+}
+
+func TestMemoryLimitController_Metric(t *testing.T) {
+	usage := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_memory_used"})
+	m := NewMemoryLimitController(100, usage)
+
+	assert.True(t, m.TryReserveMemory(70))
+	assert.Equal(t, float64(70), testutil.ToFloat64(usage))
+
+	m.ReleaseMemory(70)
+	assert.Equal(t, float64(0), testutil.ToFloat64(usage))
+}
@@ -57,6 +57,7 @@ type Metrics struct {
 	LookupRequestsCount                   prometheus.Counter
 	PartitionedTopicMetadataRequestsCount prometheus.Counter
 	RPCRequestCount                       prometheus.Counter
+	MemoryUsed                            prometheus.Gauge
 }
 
 type LeveledMetrics struct {
@@ -295,6 +296,12 @@ func NewMetricsProvider(metricsCardinality int, userDefinedLabels map[string]str
 			Help:        "Counter of RPC requests made by the client",
 			ConstLabels: constLabels,
 		}),
+
+		MemoryUsed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "pulsar_client_memory_used",
+			Help:        "Bytes of the pending messages reserved by the memory limit of the client",
+			ConstLabels: constLabels,
+		}),
 	}
 
 	err := prometheus.DefaultRegisterer.Register(metrics.messagesPublished)
@@ -477,6 +484,12 @@ func NewMetricsProvider(metricsCardinality int, userDefinedLabels map[string]str
 			metrics.RPCRequestCount = are.ExistingCollector.(prometheus.Counter)
 		}
 	}
+	err = prometheus.DefaultRegisterer.Register(metrics.MemoryUsed)
+	if err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			metrics.MemoryUsed = are.ExistingCollector.(prometheus.Gauge)
+		}
+	}
 	return metrics
 }
 
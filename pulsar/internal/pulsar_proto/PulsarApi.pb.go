@@ -298,6 +298,7 @@ const (
 	ProtocolVersion_v15 ProtocolVersion = 15
 	ProtocolVersion_v16 ProtocolVersion = 16
 	ProtocolVersion_v17 ProtocolVersion = 17
+	ProtocolVersion_v18 ProtocolVersion = 18
 )
 
 var ProtocolVersion_name = map[int32]string{
@@ -319,6 +320,7 @@ var ProtocolVersion_name = map[int32]string{
 	15: "v15",
 	16: "v16",
 	17: "v17",
+	18: "v18",
 }
 
 var ProtocolVersion_value = map[string]int32{
@@ -340,6 +342,7 @@ var ProtocolVersion_value = map[string]int32{
 	"v15": 15,
 	"v16": 16,
 	"v17": 17,
+	"v18": 18,
 }
 
 func (x ProtocolVersion) Enum() *ProtocolVersion {
@@ -901,6 +904,10 @@ const (
 	BaseCommand_END_TXN_ON_PARTITION_RESPONSE    BaseCommand_Type = 59
 	BaseCommand_END_TXN_ON_SUBSCRIPTION          BaseCommand_Type = 60
 	BaseCommand_END_TXN_ON_SUBSCRIPTION_RESPONSE BaseCommand_Type = 61
+	BaseCommand_WATCH_TOPIC_LIST                 BaseCommand_Type = 64
+	BaseCommand_WATCH_TOPIC_LIST_SUCCESS         BaseCommand_Type = 65
+	BaseCommand_WATCH_TOPIC_UPDATE               BaseCommand_Type = 66
+	BaseCommand_WATCH_TOPIC_LIST_CLOSE           BaseCommand_Type = 67
 )
 
 var BaseCommand_Type_name = map[int32]string{
@@ -955,6 +962,10 @@ var BaseCommand_Type_name = map[int32]string{
 	59: "END_TXN_ON_PARTITION_RESPONSE",
 	60: "END_TXN_ON_SUBSCRIPTION",
 	61: "END_TXN_ON_SUBSCRIPTION_RESPONSE",
+	64: "WATCH_TOPIC_LIST",
+	65: "WATCH_TOPIC_LIST_SUCCESS",
+	66: "WATCH_TOPIC_UPDATE",
+	67: "WATCH_TOPIC_LIST_CLOSE",
 }
 
 var BaseCommand_Type_value = map[string]int32{
@@ -1009,6 +1020,10 @@ var BaseCommand_Type_value = map[string]int32{
 	"END_TXN_ON_PARTITION_RESPONSE":     59,
 	"END_TXN_ON_SUBSCRIPTION":           60,
 	"END_TXN_ON_SUBSCRIPTION_RESPONSE":  61,
+	"WATCH_TOPIC_LIST":                  64,
+	"WATCH_TOPIC_LIST_SUCCESS":          65,
+	"WATCH_TOPIC_UPDATE":                66,
+	"WATCH_TOPIC_LIST_CLOSE":            67,
 }
 
 func (x BaseCommand_Type) Enum() *BaseCommand_Type {
@@ -1031,7 +1046,7 @@ func (x *BaseCommand_Type) UnmarshalJSON(data []byte) error {
 }
 
 func (BaseCommand_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{64, 0}
+	return fileDescriptor_39529ba7ad9caeb8, []int{68, 0}
 }
 
 type Schema struct {
@@ -2037,6 +2052,8 @@ func (m *CommandConnect) GetFeatureFlags() *FeatureFlags {
 type FeatureFlags struct {
 	SupportsAuthRefresh         *bool    `protobuf:"varint,1,opt,name=supports_auth_refresh,json=supportsAuthRefresh,def=0" json:"supports_auth_refresh,omitempty"`
 	SupportsBrokerEntryMetadata *bool    `protobuf:"varint,2,opt,name=supports_broker_entry_metadata,json=supportsBrokerEntryMetadata,def=0" json:"supports_broker_entry_metadata,omitempty"`
+	SupportsPartialProducer     *bool    `protobuf:"varint,3,opt,name=supports_partial_producer,json=supportsPartialProducer,def=0" json:"supports_partial_producer,omitempty"`
+	SupportsTopicWatchers       *bool    `protobuf:"varint,4,opt,name=supports_topic_watchers,json=supportsTopicWatchers,def=0" json:"supports_topic_watchers,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
 	XXX_unrecognized            []byte   `json:"-"`
 	XXX_sizecache               int32    `json:"-"`
@@ -2077,6 +2094,8 @@ var xxx_messageInfo_FeatureFlags proto.InternalMessageInfo
 
 const Default_FeatureFlags_SupportsAuthRefresh bool = false
 const Default_FeatureFlags_SupportsBrokerEntryMetadata bool = false
+const Default_FeatureFlags_SupportsPartialProducer bool = false
+const Default_FeatureFlags_SupportsTopicWatchers bool = false
 
 func (m *FeatureFlags) GetSupportsAuthRefresh() bool {
 	if m != nil && m.SupportsAuthRefresh != nil {
@@ -2092,13 +2111,28 @@ func (m *FeatureFlags) GetSupportsBrokerEntryMetadata() bool {
 	return Default_FeatureFlags_SupportsBrokerEntryMetadata
 }
 
+func (m *FeatureFlags) GetSupportsPartialProducer() bool {
+	if m != nil && m.SupportsPartialProducer != nil {
+		return *m.SupportsPartialProducer
+	}
+	return Default_FeatureFlags_SupportsPartialProducer
+}
+
+func (m *FeatureFlags) GetSupportsTopicWatchers() bool {
+	if m != nil && m.SupportsTopicWatchers != nil {
+		return *m.SupportsTopicWatchers
+	}
+	return Default_FeatureFlags_SupportsTopicWatchers
+}
+
 type CommandConnected struct {
-	ServerVersion        *string  `protobuf:"bytes,1,req,name=server_version,json=serverVersion" json:"server_version,omitempty"`
-	ProtocolVersion      *int32   `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,def=0" json:"protocol_version,omitempty"`
-	MaxMessageSize       *int32   `protobuf:"varint,3,opt,name=max_message_size,json=maxMessageSize" json:"max_message_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ServerVersion        *string       `protobuf:"bytes,1,req,name=server_version,json=serverVersion" json:"server_version,omitempty"`
+	ProtocolVersion      *int32        `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,def=0" json:"protocol_version,omitempty"`
+	MaxMessageSize       *int32        `protobuf:"varint,3,opt,name=max_message_size,json=maxMessageSize" json:"max_message_size,omitempty"`
+	FeatureFlags         *FeatureFlags `protobuf:"bytes,4,opt,name=feature_flags,json=featureFlags" json:"feature_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *CommandConnected) Reset()         { *m = CommandConnected{} }
@@ -2157,6 +2191,13 @@ func (m *CommandConnected) GetMaxMessageSize() int32 {
 	return 0
 }
 
+func (m *CommandConnected) GetFeatureFlags() *FeatureFlags {
+	if m != nil {
+		return m.FeatureFlags
+	}
+	return nil
+}
+
 type CommandAuthResponse struct {
 	ClientVersion        *string   `protobuf:"bytes,1,opt,name=client_version,json=clientVersion" json:"client_version,omitempty"`
 	Response             *AuthData `protobuf:"bytes,2,opt,name=response" json:"response,omitempty"`
@@ -4751,6 +4792,8 @@ type CommandGetTopicsOfNamespace struct {
 	RequestId            *uint64                           `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
 	Namespace            *string                           `protobuf:"bytes,2,req,name=namespace" json:"namespace,omitempty"`
 	Mode                 *CommandGetTopicsOfNamespace_Mode `protobuf:"varint,3,opt,name=mode,enum=pulsar.proto.CommandGetTopicsOfNamespace_Mode,def=0" json:"mode,omitempty"`
+	TopicsPattern        *string                           `protobuf:"bytes,4,opt,name=topics_pattern,json=topicsPattern" json:"topics_pattern,omitempty"`
+	TopicsHash           *string                           `protobuf:"bytes,5,opt,name=topics_hash,json=topicsHash" json:"topics_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -4812,9 +4855,29 @@ func (m *CommandGetTopicsOfNamespace) GetMode() CommandGetTopicsOfNamespace_Mode
 	return Default_CommandGetTopicsOfNamespace_Mode
 }
 
+func (m *CommandGetTopicsOfNamespace) GetTopicsPattern() string {
+	if m != nil && m.TopicsPattern != nil {
+		return *m.TopicsPattern
+	}
+	return ""
+}
+
+func (m *CommandGetTopicsOfNamespace) GetTopicsHash() string {
+	if m != nil && m.TopicsHash != nil {
+		return *m.TopicsHash
+	}
+	return ""
+}
+
 type CommandGetTopicsOfNamespaceResponse struct {
-	RequestId            *uint64  `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
-	Topics               []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	RequestId *uint64  `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Topics    []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// true iff the topic list was filtered by the pattern supplied by the client
+	Filtered *bool `protobuf:"varint,3,opt,name=filtered,def=0" json:"filtered,omitempty"`
+	// hash computed from the names of matching topics
+	TopicsHash *string `protobuf:"bytes,4,opt,name=topics_hash,json=topicsHash" json:"topics_hash,omitempty"`
+	// if false, topics is empty and the list of matching topics has not changed
+	Changed              *bool    `protobuf:"varint,5,opt,name=changed,def=1" json:"changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4853,6 +4916,9 @@ func (m *CommandGetTopicsOfNamespaceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommandGetTopicsOfNamespaceResponse proto.InternalMessageInfo
 
+const Default_CommandGetTopicsOfNamespaceResponse_Filtered bool = false
+const Default_CommandGetTopicsOfNamespaceResponse_Changed bool = true
+
 func (m *CommandGetTopicsOfNamespaceResponse) GetRequestId() uint64 {
 	if m != nil && m.RequestId != nil {
 		return *m.RequestId
@@ -4867,6 +4933,304 @@ func (m *CommandGetTopicsOfNamespaceResponse) GetTopics() []string {
 	return nil
 }
 
+func (m *CommandGetTopicsOfNamespaceResponse) GetFiltered() bool {
+	if m != nil && m.Filtered != nil {
+		return *m.Filtered
+	}
+	return Default_CommandGetTopicsOfNamespaceResponse_Filtered
+}
+
+func (m *CommandGetTopicsOfNamespaceResponse) GetTopicsHash() string {
+	if m != nil && m.TopicsHash != nil {
+		return *m.TopicsHash
+	}
+	return ""
+}
+
+func (m *CommandGetTopicsOfNamespaceResponse) GetChanged() bool {
+	if m != nil && m.Changed != nil {
+		return *m.Changed
+	}
+	return Default_CommandGetTopicsOfNamespaceResponse_Changed
+}
+
+type CommandWatchTopicList struct {
+	RequestId     *uint64 `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
+	WatcherId     *uint64 `protobuf:"varint,2,req,name=watcher_id,json=watcherId" json:"watcher_id,omitempty"`
+	Namespace     *string `protobuf:"bytes,3,req,name=namespace" json:"namespace,omitempty"`
+	TopicsPattern *string `protobuf:"bytes,4,req,name=topics_pattern,json=topicsPattern" json:"topics_pattern,omitempty"`
+	// Only present when the client reconnects:
+	TopicsHash           *string  `protobuf:"bytes,5,opt,name=topics_hash,json=topicsHash" json:"topics_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommandWatchTopicList) Reset()         { *m = CommandWatchTopicList{} }
+func (m *CommandWatchTopicList) String() string { return proto.CompactTextString(m) }
+func (*CommandWatchTopicList) ProtoMessage()    {}
+func (*CommandWatchTopicList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39529ba7ad9caeb8, []int{47}
+}
+func (m *CommandWatchTopicList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandWatchTopicList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandWatchTopicList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandWatchTopicList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandWatchTopicList.Merge(m, src)
+}
+func (m *CommandWatchTopicList) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandWatchTopicList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandWatchTopicList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandWatchTopicList proto.InternalMessageInfo
+
+func (m *CommandWatchTopicList) GetRequestId() uint64 {
+	if m != nil && m.RequestId != nil {
+		return *m.RequestId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicList) GetWatcherId() uint64 {
+	if m != nil && m.WatcherId != nil {
+		return *m.WatcherId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicList) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *CommandWatchTopicList) GetTopicsPattern() string {
+	if m != nil && m.TopicsPattern != nil {
+		return *m.TopicsPattern
+	}
+	return ""
+}
+
+func (m *CommandWatchTopicList) GetTopicsHash() string {
+	if m != nil && m.TopicsHash != nil {
+		return *m.TopicsHash
+	}
+	return ""
+}
+
+type CommandWatchTopicListSuccess struct {
+	RequestId            *uint64  `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
+	WatcherId            *uint64  `protobuf:"varint,2,req,name=watcher_id,json=watcherId" json:"watcher_id,omitempty"`
+	Topic                []string `protobuf:"bytes,3,rep,name=topic" json:"topic,omitempty"`
+	TopicsHash           *string  `protobuf:"bytes,4,req,name=topics_hash,json=topicsHash" json:"topics_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommandWatchTopicListSuccess) Reset()         { *m = CommandWatchTopicListSuccess{} }
+func (m *CommandWatchTopicListSuccess) String() string { return proto.CompactTextString(m) }
+func (*CommandWatchTopicListSuccess) ProtoMessage()    {}
+func (*CommandWatchTopicListSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39529ba7ad9caeb8, []int{48}
+}
+func (m *CommandWatchTopicListSuccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandWatchTopicListSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandWatchTopicListSuccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandWatchTopicListSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandWatchTopicListSuccess.Merge(m, src)
+}
+func (m *CommandWatchTopicListSuccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandWatchTopicListSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandWatchTopicListSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandWatchTopicListSuccess proto.InternalMessageInfo
+
+func (m *CommandWatchTopicListSuccess) GetRequestId() uint64 {
+	if m != nil && m.RequestId != nil {
+		return *m.RequestId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicListSuccess) GetWatcherId() uint64 {
+	if m != nil && m.WatcherId != nil {
+		return *m.WatcherId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicListSuccess) GetTopic() []string {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *CommandWatchTopicListSuccess) GetTopicsHash() string {
+	if m != nil && m.TopicsHash != nil {
+		return *m.TopicsHash
+	}
+	return ""
+}
+
+type CommandWatchTopicUpdate struct {
+	WatcherId            *uint64  `protobuf:"varint,1,req,name=watcher_id,json=watcherId" json:"watcher_id,omitempty"`
+	NewTopics            []string `protobuf:"bytes,2,rep,name=new_topics,json=newTopics" json:"new_topics,omitempty"`
+	DeletedTopics        []string `protobuf:"bytes,3,rep,name=deleted_topics,json=deletedTopics" json:"deleted_topics,omitempty"`
+	TopicsHash           *string  `protobuf:"bytes,4,req,name=topics_hash,json=topicsHash" json:"topics_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommandWatchTopicUpdate) Reset()         { *m = CommandWatchTopicUpdate{} }
+func (m *CommandWatchTopicUpdate) String() string { return proto.CompactTextString(m) }
+func (*CommandWatchTopicUpdate) ProtoMessage()    {}
+func (*CommandWatchTopicUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39529ba7ad9caeb8, []int{49}
+}
+func (m *CommandWatchTopicUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandWatchTopicUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandWatchTopicUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandWatchTopicUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandWatchTopicUpdate.Merge(m, src)
+}
+func (m *CommandWatchTopicUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandWatchTopicUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandWatchTopicUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandWatchTopicUpdate proto.InternalMessageInfo
+
+func (m *CommandWatchTopicUpdate) GetWatcherId() uint64 {
+	if m != nil && m.WatcherId != nil {
+		return *m.WatcherId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicUpdate) GetNewTopics() []string {
+	if m != nil {
+		return m.NewTopics
+	}
+	return nil
+}
+
+func (m *CommandWatchTopicUpdate) GetDeletedTopics() []string {
+	if m != nil {
+		return m.DeletedTopics
+	}
+	return nil
+}
+
+func (m *CommandWatchTopicUpdate) GetTopicsHash() string {
+	if m != nil && m.TopicsHash != nil {
+		return *m.TopicsHash
+	}
+	return ""
+}
+
+type CommandWatchTopicListClose struct {
+	RequestId            *uint64  `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
+	WatcherId            *uint64  `protobuf:"varint,2,req,name=watcher_id,json=watcherId" json:"watcher_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommandWatchTopicListClose) Reset()         { *m = CommandWatchTopicListClose{} }
+func (m *CommandWatchTopicListClose) String() string { return proto.CompactTextString(m) }
+func (*CommandWatchTopicListClose) ProtoMessage()    {}
+func (*CommandWatchTopicListClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39529ba7ad9caeb8, []int{50}
+}
+func (m *CommandWatchTopicListClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandWatchTopicListClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandWatchTopicListClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandWatchTopicListClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandWatchTopicListClose.Merge(m, src)
+}
+func (m *CommandWatchTopicListClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandWatchTopicListClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandWatchTopicListClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandWatchTopicListClose proto.InternalMessageInfo
+
+func (m *CommandWatchTopicListClose) GetRequestId() uint64 {
+	if m != nil && m.RequestId != nil {
+		return *m.RequestId
+	}
+	return 0
+}
+
+func (m *CommandWatchTopicListClose) GetWatcherId() uint64 {
+	if m != nil && m.WatcherId != nil {
+		return *m.WatcherId
+	}
+	return 0
+}
+
 type CommandGetSchema struct {
 	RequestId            *uint64  `protobuf:"varint,1,req,name=request_id,json=requestId" json:"request_id,omitempty"`
 	Topic                *string  `protobuf:"bytes,2,req,name=topic" json:"topic,omitempty"`
@@ -4880,7 +5244,7 @@ func (m *CommandGetSchema) Reset()         { *m = CommandGetSchema{} }
 func (m *CommandGetSchema) String() string { return proto.CompactTextString(m) }
 func (*CommandGetSchema) ProtoMessage()    {}
 func (*CommandGetSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{47}
+	return fileDescriptor_39529ba7ad9caeb8, []int{51}
 }
 func (m *CommandGetSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4945,7 +5309,7 @@ func (m *CommandGetSchemaResponse) Reset()         { *m = CommandGetSchemaRespon
 func (m *CommandGetSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandGetSchemaResponse) ProtoMessage()    {}
 func (*CommandGetSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{48}
+	return fileDescriptor_39529ba7ad9caeb8, []int{52}
 }
 func (m *CommandGetSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5022,7 +5386,7 @@ func (m *CommandGetOrCreateSchema) Reset()         { *m = CommandGetOrCreateSche
 func (m *CommandGetOrCreateSchema) String() string { return proto.CompactTextString(m) }
 func (*CommandGetOrCreateSchema) ProtoMessage()    {}
 func (*CommandGetOrCreateSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{49}
+	return fileDescriptor_39529ba7ad9caeb8, []int{53}
 }
 func (m *CommandGetOrCreateSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5086,7 +5450,7 @@ func (m *CommandGetOrCreateSchemaResponse) Reset()         { *m = CommandGetOrCr
 func (m *CommandGetOrCreateSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandGetOrCreateSchemaResponse) ProtoMessage()    {}
 func (*CommandGetOrCreateSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{50}
+	return fileDescriptor_39529ba7ad9caeb8, []int{54}
 }
 func (m *CommandGetOrCreateSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5156,7 +5520,7 @@ func (m *CommandNewTxn) Reset()         { *m = CommandNewTxn{} }
 func (m *CommandNewTxn) String() string { return proto.CompactTextString(m) }
 func (*CommandNewTxn) ProtoMessage()    {}
 func (*CommandNewTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{51}
+	return fileDescriptor_39529ba7ad9caeb8, []int{55}
 }
 func (m *CommandNewTxn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5224,7 +5588,7 @@ func (m *CommandNewTxnResponse) Reset()         { *m = CommandNewTxnResponse{} }
 func (m *CommandNewTxnResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandNewTxnResponse) ProtoMessage()    {}
 func (*CommandNewTxnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{52}
+	return fileDescriptor_39529ba7ad9caeb8, []int{56}
 }
 func (m *CommandNewTxnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5305,7 +5669,7 @@ func (m *CommandAddPartitionToTxn) Reset()         { *m = CommandAddPartitionToT
 func (m *CommandAddPartitionToTxn) String() string { return proto.CompactTextString(m) }
 func (*CommandAddPartitionToTxn) ProtoMessage()    {}
 func (*CommandAddPartitionToTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{53}
+	return fileDescriptor_39529ba7ad9caeb8, []int{57}
 }
 func (m *CommandAddPartitionToTxn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5380,7 +5744,7 @@ func (m *CommandAddPartitionToTxnResponse) Reset()         { *m = CommandAddPart
 func (m *CommandAddPartitionToTxnResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandAddPartitionToTxnResponse) ProtoMessage()    {}
 func (*CommandAddPartitionToTxnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{54}
+	return fileDescriptor_39529ba7ad9caeb8, []int{58}
 }
 func (m *CommandAddPartitionToTxnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5459,7 +5823,7 @@ func (m *Subscription) Reset()         { *m = Subscription{} }
 func (m *Subscription) String() string { return proto.CompactTextString(m) }
 func (*Subscription) ProtoMessage()    {}
 func (*Subscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{55}
+	return fileDescriptor_39529ba7ad9caeb8, []int{59}
 }
 func (m *Subscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5516,7 +5880,7 @@ func (m *CommandAddSubscriptionToTxn) Reset()         { *m = CommandAddSubscript
 func (m *CommandAddSubscriptionToTxn) String() string { return proto.CompactTextString(m) }
 func (*CommandAddSubscriptionToTxn) ProtoMessage()    {}
 func (*CommandAddSubscriptionToTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{56}
+	return fileDescriptor_39529ba7ad9caeb8, []int{60}
 }
 func (m *CommandAddSubscriptionToTxn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5591,7 +5955,7 @@ func (m *CommandAddSubscriptionToTxnResponse) Reset()         { *m = CommandAddS
 func (m *CommandAddSubscriptionToTxnResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandAddSubscriptionToTxnResponse) ProtoMessage()    {}
 func (*CommandAddSubscriptionToTxnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{57}
+	return fileDescriptor_39529ba7ad9caeb8, []int{61}
 }
 func (m *CommandAddSubscriptionToTxnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5672,7 +6036,7 @@ func (m *CommandEndTxn) Reset()         { *m = CommandEndTxn{} }
 func (m *CommandEndTxn) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxn) ProtoMessage()    {}
 func (*CommandEndTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{58}
+	return fileDescriptor_39529ba7ad9caeb8, []int{62}
 }
 func (m *CommandEndTxn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5747,7 +6111,7 @@ func (m *CommandEndTxnResponse) Reset()         { *m = CommandEndTxnResponse{} }
 func (m *CommandEndTxnResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxnResponse) ProtoMessage()    {}
 func (*CommandEndTxnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{59}
+	return fileDescriptor_39529ba7ad9caeb8, []int{63}
 }
 func (m *CommandEndTxnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5830,7 +6194,7 @@ func (m *CommandEndTxnOnPartition) Reset()         { *m = CommandEndTxnOnPartiti
 func (m *CommandEndTxnOnPartition) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxnOnPartition) ProtoMessage()    {}
 func (*CommandEndTxnOnPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{60}
+	return fileDescriptor_39529ba7ad9caeb8, []int{64}
 }
 func (m *CommandEndTxnOnPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5919,7 +6283,7 @@ func (m *CommandEndTxnOnPartitionResponse) Reset()         { *m = CommandEndTxnO
 func (m *CommandEndTxnOnPartitionResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxnOnPartitionResponse) ProtoMessage()    {}
 func (*CommandEndTxnOnPartitionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{61}
+	return fileDescriptor_39529ba7ad9caeb8, []int{65}
 }
 func (m *CommandEndTxnOnPartitionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6002,7 +6366,7 @@ func (m *CommandEndTxnOnSubscription) Reset()         { *m = CommandEndTxnOnSubs
 func (m *CommandEndTxnOnSubscription) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxnOnSubscription) ProtoMessage()    {}
 func (*CommandEndTxnOnSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{62}
+	return fileDescriptor_39529ba7ad9caeb8, []int{66}
 }
 func (m *CommandEndTxnOnSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6091,7 +6455,7 @@ func (m *CommandEndTxnOnSubscriptionResponse) Reset()         { *m = CommandEndT
 func (m *CommandEndTxnOnSubscriptionResponse) String() string { return proto.CompactTextString(m) }
 func (*CommandEndTxnOnSubscriptionResponse) ProtoMessage()    {}
 func (*CommandEndTxnOnSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{63}
+	return fileDescriptor_39529ba7ad9caeb8, []int{67}
 }
 func (m *CommandEndTxnOnSubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6212,6 +6576,10 @@ type BaseCommand struct {
 	EndTxnOnPartitionResponse    *CommandEndTxnOnPartitionResponse    `protobuf:"bytes,59,opt,name=endTxnOnPartitionResponse" json:"endTxnOnPartitionResponse,omitempty"`
 	EndTxnOnSubscription         *CommandEndTxnOnSubscription         `protobuf:"bytes,60,opt,name=endTxnOnSubscription" json:"endTxnOnSubscription,omitempty"`
 	EndTxnOnSubscriptionResponse *CommandEndTxnOnSubscriptionResponse `protobuf:"bytes,61,opt,name=endTxnOnSubscriptionResponse" json:"endTxnOnSubscriptionResponse,omitempty"`
+	WatchTopicList               *CommandWatchTopicList               `protobuf:"bytes,64,opt,name=watchTopicList" json:"watchTopicList,omitempty"`
+	WatchTopicListSuccess        *CommandWatchTopicListSuccess        `protobuf:"bytes,65,opt,name=watchTopicListSuccess" json:"watchTopicListSuccess,omitempty"`
+	WatchTopicUpdate             *CommandWatchTopicUpdate             `protobuf:"bytes,66,opt,name=watchTopicUpdate" json:"watchTopicUpdate,omitempty"`
+	WatchTopicListClose          *CommandWatchTopicListClose          `protobuf:"bytes,67,opt,name=watchTopicListClose" json:"watchTopicListClose,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}                             `json:"-"`
 	XXX_unrecognized             []byte                               `json:"-"`
 	XXX_sizecache                int32                                `json:"-"`
@@ -6221,7 +6589,7 @@ func (m *BaseCommand) Reset()         { *m = BaseCommand{} }
 func (m *BaseCommand) String() string { return proto.CompactTextString(m) }
 func (*BaseCommand) ProtoMessage()    {}
 func (*BaseCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39529ba7ad9caeb8, []int{64}
+	return fileDescriptor_39529ba7ad9caeb8, []int{68}
 }
 func (m *BaseCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6614,19 +6982,47 @@ func (m *BaseCommand) GetEndTxnOnSubscriptionResponse() *CommandEndTxnOnSubscrip
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pulsar.proto.CompressionType", CompressionType_name, CompressionType_value)
-	proto.RegisterEnum("pulsar.proto.ProducerAccessMode", ProducerAccessMode_name, ProducerAccessMode_value)
-	proto.RegisterEnum("pulsar.proto.ServerError", ServerError_name, ServerError_value)
-	proto.RegisterEnum("pulsar.proto.AuthMethod", AuthMethod_name, AuthMethod_value)
-	proto.RegisterEnum("pulsar.proto.ProtocolVersion", ProtocolVersion_name, ProtocolVersion_value)
-	proto.RegisterEnum("pulsar.proto.KeySharedMode", KeySharedMode_name, KeySharedMode_value)
-	proto.RegisterEnum("pulsar.proto.TxnAction", TxnAction_name, TxnAction_value)
-	proto.RegisterEnum("pulsar.proto.Schema_Type", Schema_Type_name, Schema_Type_value)
-	proto.RegisterEnum("pulsar.proto.CommandSubscribe_SubType", CommandSubscribe_SubType_name, CommandSubscribe_SubType_value)
-	proto.RegisterEnum("pulsar.proto.CommandSubscribe_InitialPosition", CommandSubscribe_InitialPosition_name, CommandSubscribe_InitialPosition_value)
-	proto.RegisterEnum("pulsar.proto.CommandPartitionedTopicMetadataResponse_LookupType", CommandPartitionedTopicMetadataResponse_LookupType_name, CommandPartitionedTopicMetadataResponse_LookupType_value)
-	proto.RegisterEnum("pulsar.proto.CommandLookupTopicResponse_LookupType", CommandLookupTopicResponse_LookupType_name, CommandLookupTopicResponse_LookupType_value)
+func (m *BaseCommand) GetWatchTopicList() *CommandWatchTopicList {
+	if m != nil {
+		return m.WatchTopicList
+	}
+	return nil
+}
+
+func (m *BaseCommand) GetWatchTopicListSuccess() *CommandWatchTopicListSuccess {
+	if m != nil {
+		return m.WatchTopicListSuccess
+	}
+	return nil
+}
+
+func (m *BaseCommand) GetWatchTopicUpdate() *CommandWatchTopicUpdate {
+	if m != nil {
+		return m.WatchTopicUpdate
+	}
+	return nil
+}
+
+func (m *BaseCommand) GetWatchTopicListClose() *CommandWatchTopicListClose {
+	if m != nil {
+		return m.WatchTopicListClose
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pulsar.proto.CompressionType", CompressionType_name, CompressionType_value)
+	proto.RegisterEnum("pulsar.proto.ProducerAccessMode", ProducerAccessMode_name, ProducerAccessMode_value)
+	proto.RegisterEnum("pulsar.proto.ServerError", ServerError_name, ServerError_value)
+	proto.RegisterEnum("pulsar.proto.AuthMethod", AuthMethod_name, AuthMethod_value)
+	proto.RegisterEnum("pulsar.proto.ProtocolVersion", ProtocolVersion_name, ProtocolVersion_value)
+	proto.RegisterEnum("pulsar.proto.KeySharedMode", KeySharedMode_name, KeySharedMode_value)
+	proto.RegisterEnum("pulsar.proto.TxnAction", TxnAction_name, TxnAction_value)
+	proto.RegisterEnum("pulsar.proto.Schema_Type", Schema_Type_name, Schema_Type_value)
+	proto.RegisterEnum("pulsar.proto.CommandSubscribe_SubType", CommandSubscribe_SubType_name, CommandSubscribe_SubType_value)
+	proto.RegisterEnum("pulsar.proto.CommandSubscribe_InitialPosition", CommandSubscribe_InitialPosition_name, CommandSubscribe_InitialPosition_value)
+	proto.RegisterEnum("pulsar.proto.CommandPartitionedTopicMetadataResponse_LookupType", CommandPartitionedTopicMetadataResponse_LookupType_name, CommandPartitionedTopicMetadataResponse_LookupType_value)
+	proto.RegisterEnum("pulsar.proto.CommandLookupTopicResponse_LookupType", CommandLookupTopicResponse_LookupType_name, CommandLookupTopicResponse_LookupType_value)
 	proto.RegisterEnum("pulsar.proto.CommandAck_AckType", CommandAck_AckType_name, CommandAck_AckType_value)
 	proto.RegisterEnum("pulsar.proto.CommandAck_ValidationError", CommandAck_ValidationError_name, CommandAck_ValidationError_value)
 	proto.RegisterEnum("pulsar.proto.CommandGetTopicsOfNamespace_Mode", CommandGetTopicsOfNamespace_Mode_name, CommandGetTopicsOfNamespace_Mode_value)
@@ -6678,6 +7074,10 @@ func init() {
 	proto.RegisterType((*CommandGetLastMessageIdResponse)(nil), "pulsar.proto.CommandGetLastMessageIdResponse")
 	proto.RegisterType((*CommandGetTopicsOfNamespace)(nil), "pulsar.proto.CommandGetTopicsOfNamespace")
 	proto.RegisterType((*CommandGetTopicsOfNamespaceResponse)(nil), "pulsar.proto.CommandGetTopicsOfNamespaceResponse")
+	proto.RegisterType((*CommandWatchTopicList)(nil), "pulsar.proto.CommandWatchTopicList")
+	proto.RegisterType((*CommandWatchTopicListSuccess)(nil), "pulsar.proto.CommandWatchTopicListSuccess")
+	proto.RegisterType((*CommandWatchTopicUpdate)(nil), "pulsar.proto.CommandWatchTopicUpdate")
+	proto.RegisterType((*CommandWatchTopicListClose)(nil), "pulsar.proto.CommandWatchTopicListClose")
 	proto.RegisterType((*CommandGetSchema)(nil), "pulsar.proto.CommandGetSchema")
 	proto.RegisterType((*CommandGetSchemaResponse)(nil), "pulsar.proto.CommandGetSchemaResponse")
 	proto.RegisterType((*CommandGetOrCreateSchema)(nil), "pulsar.proto.CommandGetOrCreateSchema")
@@ -6701,393 +7101,412 @@ func init() {
 func init() { proto.RegisterFile("PulsarApi.proto", fileDescriptor_39529ba7ad9caeb8) }
 
 var fileDescriptor_39529ba7ad9caeb8 = []byte{
-	// 6163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4b, 0x70, 0x1b, 0x47,
-	0x76, 0x1a, 0x00, 0x24, 0x81, 0x07, 0x02, 0x6c, 0x35, 0x29, 0x69, 0xf4, 0x31, 0x45, 0x8d, 0x2c,
-	0x9b, 0x96, 0x2d, 0xad, 0x44, 0xc9, 0xb2, 0x2d, 0x7b, 0xb3, 0x06, 0x41, 0x48, 0xc2, 0x92, 0x04,
-	0xb8, 0x03, 0x50, 0x8e, 0x9d, 0xdd, 0xcc, 0x0e, 0x67, 0x9a, 0xe0, 0x14, 0x07, 0x33, 0xd8, 0x99,
-	0x01, 0x25, 0xba, 0x2a, 0x39, 0xe4, 0x92, 0x53, 0x2a, 0x95, 0x43, 0xae, 0x49, 0x25, 0xa7, 0xe4,
-	0x98, 0xaa, 0x3d, 0xa4, 0x2a, 0x55, 0xc9, 0x25, 0x9f, 0xad, 0xca, 0x25, 0xa9, 0x4a, 0x0e, 0x7b,
-	0xda, 0xd4, 0x56, 0x3e, 0x87, 0xad, 0xa4, 0x72, 0xcb, 0x31, 0xa9, 0xfe, 0xcc, 0x0f, 0x18, 0x00,
-	0x94, 0xbd, 0x29, 0xbb, 0x7c, 0xc2, 0xcc, 0xeb, 0xd7, 0x6f, 0x5e, 0xbf, 0xf7, 0xfa, 0xbd, 0xd7,
-	0xaf, 0xbb, 0x01, 0x4b, 0x7b, 0x43, 0xdb, 0xd7, 0xbd, 0xda, 0xc0, 0xba, 0x3b, 0xf0, 0xdc, 0xc0,
-	0xc5, 0x8b, 0x03, 0x06, 0xe0, 0x6f, 0xca, 0x8f, 0xf3, 0x30, 0xdf, 0x31, 0x8e, 0x48, 0x5f, 0xc7,
-	0x18, 0x0a, 0x8e, 0xde, 0x27, 0xb2, 0xb4, 0x96, 0x5b, 0x2f, 0xa9, 0xec, 0x19, 0x5f, 0x87, 0xb2,
-	0xcf, 0x5a, 0x35, 0x53, 0x0f, 0x74, 0x39, 0xbf, 0x96, 0x5b, 0x5f, 0x54, 0x81, 0x83, 0xb6, 0xf4,
-	0x40, 0xc7, 0x77, 0xa0, 0x10, 0x9c, 0x0e, 0x88, 0x5c, 0x58, 0xcb, 0xad, 0x57, 0x37, 0x2e, 0xdf,
-	0x4d, 0x12, 0xbf, 0xcb, 0x09, 0xdf, 0xed, 0x9e, 0x0e, 0x88, 0xca, 0xd0, 0xf0, 0x23, 0x80, 0x81,
-	0xe7, 0x0e, 0x88, 0x17, 0x58, 0xc4, 0x97, 0xe7, 0xd6, 0xf2, 0xeb, 0xe5, 0x8d, 0x8b, 0xe9, 0x4e,
-	0xdb, 0xe4, 0xf4, 0xb9, 0x6e, 0x0f, 0x89, 0x9a, 0xc0, 0x54, 0x7e, 0x27, 0x07, 0x05, 0x4a, 0x06,
-	0x17, 0xa1, 0xd0, 0x72, 0x1d, 0x82, 0xce, 0x61, 0x80, 0xf9, 0x4e, 0xe0, 0x59, 0x4e, 0x0f, 0x49,
-	0x14, 0xfa, 0x5d, 0xdf, 0x75, 0x50, 0x0e, 0x2f, 0x42, 0x71, 0x8f, 0x92, 0x39, 0x18, 0x1e, 0xa2,
-	0x3c, 0x85, 0xd7, 0x4e, 0x3c, 0x17, 0x15, 0xe8, 0xd3, 0xa6, 0xeb, 0xda, 0x68, 0x8e, 0x3e, 0x35,
-	0x9d, 0xe0, 0x7d, 0x34, 0x8f, 0x4b, 0x30, 0xd7, 0x74, 0x82, 0xfb, 0x8f, 0xd0, 0x82, 0x78, 0x7c,
-	0xb0, 0x81, 0x8a, 0xe2, 0xf1, 0xd1, 0x43, 0x54, 0xa2, 0x8f, 0x4f, 0x6c, 0x57, 0x0f, 0x10, 0xd0,
-	0xaf, 0x6d, 0xb9, 0xc3, 0x03, 0x9b, 0xa0, 0x32, 0xa5, 0xb0, 0xa5, 0x07, 0x04, 0x2d, 0xd2, 0xa7,
-	0xae, 0xd5, 0x27, 0xa8, 0x82, 0x2b, 0x50, 0xa2, 0x4f, 0x7e, 0xa0, 0xf7, 0x07, 0xa8, 0x4a, 0xd9,
-	0x08, 0xc7, 0x81, 0x96, 0x70, 0x19, 0x16, 0x9a, 0x8e, 0x1f, 0xe8, 0x4e, 0x80, 0x10, 0xc5, 0xdc,
-	0x71, 0x0d, 0xdd, 0x66, 0x24, 0xce, 0x47, 0xaf, 0x8c, 0x0e, 0xc6, 0xe7, 0xa1, 0x12, 0xb5, 0x32,
-	0xd0, 0x32, 0xc6, 0x50, 0x0d, 0x87, 0xd4, 0xd2, 0x03, 0xeb, 0x84, 0xa0, 0x15, 0xe5, 0xaf, 0x25,
-	0xa8, 0xec, 0x12, 0xdf, 0xd7, 0x7b, 0xa4, 0x69, 0x32, 0x45, 0x5c, 0x81, 0xa2, 0x4d, 0xcc, 0x1e,
-	0xf1, 0x9a, 0x26, 0xd3, 0x60, 0x41, 0x8d, 0xde, 0xb1, 0x0c, 0x0b, 0xc4, 0x09, 0xbc, 0xd3, 0xa6,
-	0x29, 0xe7, 0x58, 0x53, 0xf8, 0x8a, 0xd7, 0xa0, 0x34, 0xd0, 0xbd, 0xc0, 0x0a, 0x2c, 0xd7, 0x91,
-	0xf3, 0x6b, 0xd2, 0xfa, 0xdc, 0xe3, 0xdc, 0x9d, 0xfb, 0x6a, 0x0c, 0xc4, 0x37, 0xa1, 0x7c, 0xa0,
-	0x07, 0xc6, 0x91, 0x66, 0x39, 0x26, 0x79, 0x29, 0x17, 0x22, 0x1c, 0x60, 0xe0, 0x26, 0x85, 0xe2,
-	0x4b, 0xb0, 0xa0, 0x1b, 0xc7, 0x9a, 0x4f, 0x02, 0xa6, 0xd3, 0xbc, 0x3a, 0xaf, 0x1b, 0xc7, 0x1d,
-	0x12, 0xe0, 0xd7, 0x80, 0xa3, 0x69, 0xbe, 0xf5, 0x39, 0x91, 0xe7, 0x69, 0x67, 0xb5, 0xc4, 0x20,
-	0x1d, 0xeb, 0x73, 0xa2, 0x6c, 0xc4, 0x62, 0xc2, 0x08, 0xf2, 0xc7, 0xe4, 0x54, 0x58, 0x1f, 0x7d,
-	0xc4, 0x2b, 0x30, 0x77, 0x42, 0x9b, 0x18, 0xd3, 0x25, 0x95, 0xbf, 0x28, 0x8f, 0x60, 0x71, 0x9b,
-	0x9c, 0xee, 0xb8, 0x4e, 0xef, 0x4c, 0xfd, 0x0a, 0x61, 0xbf, 0x0d, 0x28, 0x36, 0x9d, 0x40, 0xd5,
-	0x9d, 0x1e, 0xa1, 0x18, 0x7e, 0xa0, 0x7b, 0x01, 0xeb, 0x35, 0xa7, 0xf2, 0x17, 0x4a, 0x89, 0x38,
-	0x5c, 0x44, 0x73, 0x2a, 0x7d, 0x54, 0x6c, 0xa8, 0x36, 0x1c, 0xc3, 0x3b, 0x1d, 0x50, 0x51, 0x6c,
-	0x93, 0x53, 0x7f, 0xd6, 0xd7, 0x16, 0xc5, 0xd7, 0xf0, 0x06, 0x14, 0xfb, 0x24, 0xd0, 0xc5, 0xac,
-	0x99, 0x66, 0xe6, 0x11, 0x9e, 0xf2, 0x8f, 0x25, 0x58, 0x12, 0x4a, 0xdd, 0x15, 0x30, 0x7c, 0x13,
-	0x2a, 0x03, 0xcf, 0x35, 0x87, 0x06, 0xf1, 0xb4, 0xc4, 0xec, 0x5c, 0x0c, 0x81, 0xad, 0x70, 0x96,
-	0x92, 0x1f, 0x0d, 0x89, 0x63, 0x10, 0xcd, 0x0a, 0x75, 0x0c, 0x21, 0xa8, 0x69, 0xe2, 0x1b, 0xb0,
-	0x38, 0x18, 0x1e, 0xd8, 0x96, 0x7f, 0xa4, 0x05, 0x56, 0x9f, 0xb0, 0x79, 0x5c, 0x50, 0xcb, 0x02,
-	0x46, 0xed, 0x6c, 0x64, 0x66, 0x16, 0xce, 0x3a, 0x33, 0xf1, 0x9b, 0xb0, 0xe4, 0x91, 0x81, 0x6d,
-	0x19, 0x7a, 0x40, 0x4c, 0xed, 0xd0, 0x73, 0xfb, 0xf2, 0xdc, 0x9a, 0xb4, 0x5e, 0x52, 0xab, 0x31,
-	0xf8, 0x89, 0xe7, 0xf6, 0xd9, 0x48, 0x42, 0xab, 0xd2, 0xa8, 0x0c, 0xe7, 0x19, 0xda, 0x62, 0x04,
-	0xdc, 0x26, 0xa7, 0x94, 0xd1, 0xa8, 0x9b, 0x16, 0xb8, 0xf2, 0xc2, 0x5a, 0x7e, 0xbd, 0xa4, 0x96,
-	0x23, 0x58, 0xd7, 0xc5, 0x0d, 0x28, 0x1b, 0x6e, 0x7f, 0xe0, 0x11, 0xdf, 0xa7, 0x46, 0x5b, 0x5c,
-	0x93, 0xd6, 0xab, 0x1b, 0xaf, 0xa5, 0x39, 0xad, 0xc7, 0x08, 0xd4, 0x6b, 0x3c, 0x2e, 0xb4, 0xda,
-	0xad, 0x86, 0x9a, 0xec, 0x87, 0xef, 0xc2, 0xf9, 0xa1, 0x13, 0x02, 0x88, 0xc9, 0x0d, 0xb4, 0xb4,
-	0x26, 0xad, 0x57, 0x1e, 0x4b, 0xf7, 0x54, 0x94, 0x6c, 0xa3, 0xa6, 0x8a, 0x1f, 0xc2, 0x05, 0x67,
-	0xd8, 0xd7, 0xfa, 0x5c, 0x3f, 0xbe, 0x66, 0x39, 0x1a, 0xb3, 0x63, 0xb9, 0xcc, 0x66, 0x84, 0x74,
-	0x5f, 0xc5, 0xce, 0xb0, 0x2f, 0xd4, 0xe7, 0x37, 0x9d, 0x4d, 0xda, 0x88, 0xd7, 0x00, 0xc8, 0x09,
-	0x71, 0x02, 0x2e, 0xf6, 0xc5, 0x35, 0x69, 0xbd, 0x40, 0xc9, 0x97, 0x18, 0x90, 0xc9, 0xbd, 0x01,
-	0x4b, 0x24, 0x32, 0x31, 0x2a, 0x17, 0x5f, 0xae, 0x30, 0xe1, 0x5f, 0x4b, 0x0f, 0x29, 0x6d, 0x87,
-	0x6a, 0x95, 0xa4, 0xed, 0xf2, 0xcd, 0x14, 0x19, 0xdd, 0xee, 0xb9, 0x72, 0x95, 0xab, 0x21, 0x06,
-	0xd7, 0xec, 0x9e, 0x8b, 0xdf, 0x02, 0x94, 0x40, 0x1c, 0xe8, 0x9e, 0xde, 0x97, 0x97, 0xd6, 0xa4,
-	0xf5, 0x45, 0x35, 0x41, 0x60, 0x8f, 0x82, 0xf1, 0x2d, 0xa8, 0x0a, 0xe7, 0x7f, 0x42, 0x3c, 0x26,
-	0x6c, 0xc4, 0x10, 0x2b, 0x1c, 0xfa, 0x9c, 0x03, 0xf1, 0xc7, 0x70, 0x39, 0xa5, 0x58, 0xed, 0xe0,
-	0xd1, 0x43, 0x8d, 0x38, 0x86, 0x6b, 0x12, 0x53, 0x3e, 0xbf, 0x26, 0xad, 0x17, 0x1f, 0xcf, 0x1d,
-	0xea, 0xb6, 0x4f, 0xd4, 0x8b, 0x49, 0x5d, 0x6f, 0x3e, 0x7a, 0xd8, 0xe0, 0x48, 0x54, 0xeb, 0xae,
-	0x67, 0x12, 0xea, 0xcc, 0x99, 0x65, 0x60, 0xf6, 0x99, 0x72, 0x08, 0xa3, 0x86, 0xf1, 0x06, 0x2c,
-	0x99, 0xc4, 0xb6, 0x4e, 0x88, 0xa7, 0xe9, 0x42, 0x9a, 0xcb, 0x6b, 0xd2, 0x7a, 0x5e, 0xad, 0x08,
-	0x70, 0x8d, 0x8b, 0xf3, 0x3a, 0x94, 0xfb, 0xba, 0x77, 0x4c, 0x3c, 0x8d, 0x85, 0xa5, 0x15, 0xe6,
-	0x71, 0x80, 0x83, 0x58, 0x00, 0x59, 0x07, 0x14, 0xbc, 0x74, 0x2c, 0x53, 0xb3, 0x89, 0xee, 0x07,
-	0xda, 0x81, 0x15, 0xf8, 0xf2, 0x45, 0xaa, 0x17, 0xb5, 0xca, 0xe0, 0x3b, 0x14, 0xbc, 0x69, 0x05,
-	0x3e, 0xfd, 0x24, 0xc7, 0xec, 0xbb, 0x21, 0xe2, 0x25, 0x86, 0x58, 0x61, 0xe0, 0x5d, 0x57, 0xe0,
-	0xdd, 0x87, 0xe5, 0x23, 0xab, 0x77, 0x44, 0xfc, 0x40, 0x4b, 0xce, 0x42, 0x39, 0x54, 0xf6, 0x79,
-	0xd1, 0xda, 0x89, 0xe7, 0xe3, 0xeb, 0x00, 0xce, 0xd0, 0xb6, 0x35, 0xee, 0x38, 0x2e, 0x27, 0x65,
-	0x54, 0xa2, 0x0d, 0xdc, 0xb3, 0x61, 0x28, 0x0c, 0x87, 0x96, 0x29, 0x5f, 0x61, 0x8a, 0x64, 0xcf,
-	0xf8, 0x0e, 0x2c, 0x53, 0x33, 0x34, 0x8e, 0x86, 0xce, 0xb1, 0xcf, 0xa6, 0x9b, 0xd6, 0xf7, 0x7b,
-	0xf2, 0x55, 0x36, 0x4e, 0xe4, 0x0c, 0xfb, 0x75, 0xd6, 0x42, 0x67, 0xdc, 0xae, 0xdf, 0xc3, 0xdf,
-	0x82, 0x95, 0xc0, 0x0d, 0x74, 0x9b, 0x77, 0xa0, 0xa8, 0xdc, 0xd0, 0xaf, 0x31, 0xfc, 0xf3, 0xac,
-	0x8d, 0xf5, 0xd8, 0xf5, 0x7b, 0xcc, 0xcc, 0x2f, 0x43, 0x91, 0xa3, 0x5a, 0xa6, 0xfc, 0x1a, 0x43,
-	0x5a, 0x60, 0xef, 0x4d, 0x13, 0x3f, 0x00, 0xcc, 0x98, 0x4e, 0xcf, 0xe2, 0xd5, 0x24, 0xf3, 0x88,
-	0x22, 0xec, 0x25, 0x94, 0xac, 0xfc, 0x6d, 0x1e, 0x2e, 0x74, 0x2c, 0xa7, 0x67, 0x93, 0x51, 0xcf,
-	0x96, 0x76, 0x38, 0xd2, 0x99, 0x1d, 0xce, 0x98, 0x1f, 0xc9, 0x65, 0xfb, 0x91, 0x81, 0x7e, 0x6a,
-	0xbb, 0xba, 0x98, 0xd8, 0x79, 0xe6, 0xd3, 0xcb, 0x02, 0xc6, 0x46, 0x7a, 0x1b, 0x2a, 0x74, 0x8a,
-	0xeb, 0x06, 0xf5, 0x5b, 0xee, 0x30, 0x60, 0xa1, 0x2d, 0x1a, 0xc9, 0x62, 0xd4, 0xd6, 0x1e, 0x06,
-	0x23, 0xd3, 0x78, 0x2e, 0x63, 0x1a, 0x4f, 0x9d, 0x04, 0xf3, 0x5f, 0x64, 0x12, 0x2c, 0x8c, 0x4f,
-	0x82, 0x11, 0x3f, 0x5f, 0x64, 0xd6, 0x98, 0xf4, 0xf3, 0x69, 0xbb, 0x2a, 0x4d, 0xb0, 0xab, 0x6c,
-	0x45, 0xc2, 0x74, 0x45, 0x3e, 0x87, 0xe5, 0x4d, 0xcf, 0x3d, 0x26, 0x5e, 0x83, 0xa6, 0x0e, 0x91,
-	0x16, 0xdf, 0x02, 0x74, 0xc0, 0xc0, 0x4c, 0x34, 0x2c, 0xfd, 0x91, 0x25, 0xc6, 0xd7, 0x12, 0x87,
-	0x47, 0x59, 0x11, 0x0d, 0x94, 0x3c, 0x87, 0xc8, 0xb1, 0x76, 0xfe, 0xa2, 0xfc, 0x7b, 0x1e, 0xaa,
-	0x75, 0xb7, 0xdf, 0xd7, 0x1d, 0xb3, 0xee, 0x3a, 0x0e, 0x31, 0x02, 0xea, 0x77, 0x0c, 0xdb, 0xa2,
-	0xe2, 0x0e, 0xfd, 0x0e, 0x0f, 0x7a, 0x15, 0x0e, 0x0d, 0xfd, 0xce, 0x07, 0x50, 0xd6, 0x87, 0xc1,
-	0x91, 0xd6, 0x27, 0xc1, 0x91, 0x6b, 0x32, 0xaa, 0xd5, 0x0d, 0x39, 0x6d, 0x41, 0xb5, 0x61, 0x70,
-	0xb4, 0xcb, 0xda, 0x55, 0xd0, 0xa3, 0x67, 0xea, 0x04, 0x12, 0x5d, 0x79, 0x60, 0x15, 0x51, 0x2b,
-	0xc6, 0x62, 0xa1, 0xf5, 0x2a, 0x94, 0x18, 0xa6, 0x08, 0xe4, 0x54, 0x25, 0x45, 0x0a, 0x60, 0x39,
-	0xd7, 0x3b, 0x80, 0xd8, 0x67, 0x0c, 0xd7, 0x8e, 0x58, 0xe5, 0x09, 0x92, 0x74, 0x4f, 0x5d, 0x0a,
-	0x9b, 0x42, 0x7e, 0xef, 0xc0, 0xf2, 0xc0, 0x73, 0x5f, 0x9e, 0x6a, 0x81, 0xab, 0x09, 0x99, 0x0d,
-	0x3d, 0x5b, 0x84, 0x41, 0xc4, 0x9a, 0xba, 0x2e, 0x97, 0xf1, 0xbe, 0x67, 0xe3, 0x3b, 0x80, 0x5d,
-	0xcf, 0xea, 0x59, 0x8e, 0x6e, 0x6b, 0x03, 0xcf, 0x72, 0x0c, 0x6b, 0xa0, 0xdb, 0xcc, 0x2a, 0x4a,
-	0xea, 0xf9, 0xb0, 0x65, 0x2f, 0x6c, 0xc0, 0xef, 0x24, 0xd0, 0x63, 0x8e, 0x8b, 0x9c, 0x78, 0xd8,
-	0x52, 0x0b, 0x39, 0xbf, 0x07, 0x2b, 0x69, 0x6c, 0x21, 0xc4, 0x12, 0xc3, 0xc7, 0x49, 0x7c, 0x21,
-	0xb2, 0xef, 0x40, 0xe5, 0x90, 0xe8, 0xc1, 0xd0, 0x23, 0xda, 0xa1, 0xad, 0xf7, 0x7c, 0x66, 0x2f,
-	0xe5, 0x8d, 0x2b, 0x69, 0x79, 0x3f, 0xe1, 0x28, 0x4f, 0x28, 0x86, 0xba, 0x78, 0x98, 0x78, 0x53,
-	0x7e, 0x5f, 0x82, 0xc5, 0x64, 0x33, 0xfe, 0x00, 0x2e, 0xf8, 0xc3, 0xc1, 0xc0, 0xf5, 0x02, 0x9f,
-	0xf3, 0xe0, 0x91, 0x43, 0x8f, 0xf8, 0x47, 0xcc, 0x7e, 0x22, 0x4b, 0x5c, 0x0e, 0x71, 0x28, 0x2f,
-	0x2a, 0xc7, 0xc0, 0xdf, 0x85, 0xd5, 0xa8, 0xab, 0x10, 0x25, 0xcb, 0x68, 0xb5, 0x28, 0xe7, 0xca,
-	0x25, 0x69, 0x5c, 0x0d, 0x91, 0x33, 0x2c, 0x58, 0xf9, 0x3d, 0x09, 0x50, 0xda, 0x00, 0x89, 0xc9,
-	0x42, 0x1f, 0xf1, 0x68, 0xb4, 0x19, 0x31, 0x41, 0x0e, 0x0d, 0x55, 0x9a, 0x65, 0x00, 0xb9, 0x89,
-	0x06, 0xb0, 0x0e, 0xa8, 0xaf, 0xbf, 0x0c, 0x53, 0x88, 0xd0, 0x31, 0x51, 0x1f, 0x5b, 0xed, 0xeb,
-	0x2f, 0x85, 0x7f, 0x64, 0x79, 0xf1, 0x1f, 0x48, 0xb0, 0x2c, 0x78, 0xe2, 0xc3, 0xf6, 0x07, 0xae,
-	0xe3, 0x93, 0xcc, 0x99, 0x21, 0x8d, 0xcf, 0x8c, 0x0d, 0x28, 0x7a, 0xa2, 0x0b, 0x63, 0x67, 0xcc,
-	0xb1, 0x86, 0x76, 0xa0, 0x46, 0x78, 0x99, 0x43, 0xc9, 0x4f, 0x1a, 0x8a, 0xf2, 0x47, 0x12, 0xac,
-	0x24, 0x18, 0xac, 0x1f, 0xe9, 0xb6, 0x4d, 0x68, 0x66, 0x9d, 0x25, 0x38, 0x69, 0x5c, 0x70, 0x0f,
-	0xa1, 0x64, 0x84, 0x7d, 0x66, 0xb0, 0x18, 0x23, 0xbe, 0x22, 0x8f, 0xdf, 0x83, 0x62, 0x64, 0xef,
-	0x59, 0x13, 0x5e, 0x9a, 0x3d, 0xe1, 0x73, 0xe9, 0x09, 0xaf, 0xfc, 0xbd, 0x04, 0x95, 0x6d, 0x72,
-	0xda, 0x39, 0xd2, 0x3d, 0x62, 0x52, 0x0b, 0xc2, 0x35, 0xa8, 0x1c, 0x47, 0x00, 0xd7, 0xe4, 0xf9,
-	0x79, 0x75, 0xe3, 0xea, 0x58, 0x20, 0x8b, 0x51, 0xd4, 0x74, 0x0f, 0x1a, 0x08, 0x8f, 0x74, 0xff,
-	0x88, 0xad, 0x4c, 0xfc, 0xec, 0xc5, 0x42, 0xb8, 0x70, 0x51, 0x13, 0x98, 0xf8, 0x3b, 0x70, 0x49,
-	0xb7, 0x6d, 0xf7, 0x45, 0x7b, 0x18, 0xb4, 0x0f, 0xdb, 0x34, 0x4c, 0x6c, 0xf1, 0x54, 0xe8, 0x34,
-	0x1d, 0xca, 0x26, 0x61, 0x29, 0xbf, 0x58, 0x88, 0x2c, 0xbf, 0x33, 0x3c, 0xf0, 0x0d, 0xcf, 0x3a,
-	0x60, 0x4b, 0xa3, 0xc0, 0x1d, 0x58, 0x86, 0x30, 0x78, 0xfe, 0x82, 0x15, 0x58, 0xf4, 0x39, 0x0a,
-	0xcb, 0x0f, 0xc5, 0x8a, 0x2c, 0x05, 0xc3, 0x1f, 0xc3, 0x82, 0x3f, 0x3c, 0xa0, 0x49, 0x16, 0x0b,
-	0xb7, 0xd5, 0x8d, 0x37, 0xc6, 0x92, 0xf2, 0xd4, 0xa7, 0xee, 0x76, 0x38, 0xb6, 0x1a, 0x76, 0xa3,
-	0xf1, 0xcd, 0x70, 0x1d, 0x7f, 0xd8, 0x27, 0x1e, 0x8d, 0x6f, 0x05, 0xbe, 0x8e, 0x09, 0x41, 0x4d,
-	0x93, 0x2e, 0x27, 0x3d, 0x1a, 0xed, 0xfc, 0x80, 0xb6, 0xcf, 0xb1, 0xf6, 0x92, 0x80, 0x34, 0x4d,
-	0x9a, 0x1a, 0x44, 0xfd, 0x99, 0x8a, 0xc5, 0x12, 0x23, 0x04, 0x32, 0x05, 0xdf, 0x82, 0xea, 0xc0,
-	0xb3, 0x5c, 0xcf, 0x0a, 0x4e, 0x35, 0x9b, 0x9c, 0x10, 0xee, 0x53, 0xe7, 0xd4, 0x4a, 0x08, 0xdd,
-	0xa1, 0x40, 0xbc, 0x0a, 0x0b, 0xe6, 0xd0, 0xd3, 0x0f, 0x6c, 0xc2, 0x9c, 0x68, 0xf1, 0x71, 0x21,
-	0xf0, 0x86, 0x44, 0x0d, 0x81, 0xb8, 0x01, 0x88, 0xad, 0x1a, 0xa3, 0xe9, 0x6c, 0x71, 0xef, 0x59,
-	0x1e, 0xd5, 0x7d, 0x6a, 0x99, 0xae, 0x56, 0x59, 0xa7, 0x08, 0x96, 0x5a, 0x27, 0xc2, 0xd9, 0xd6,
-	0x89, 0x74, 0x04, 0x1e, 0xd1, 0x4d, 0x2d, 0x4a, 0x51, 0xd8, 0x1a, 0xa4, 0xa8, 0x56, 0x28, 0xb4,
-	0x1e, 0x02, 0xf1, 0x3b, 0x30, 0xcf, 0x13, 0x75, 0xb6, 0xee, 0x28, 0x6f, 0xac, 0x64, 0x15, 0x67,
-	0x54, 0x81, 0x83, 0x7f, 0x08, 0x4b, 0x96, 0x63, 0x05, 0x96, 0x6e, 0xef, 0xb9, 0x3e, 0xaf, 0x07,
-	0x54, 0x58, 0x44, 0xbd, 0x3b, 0x43, 0x8b, 0xcd, 0x74, 0xaf, 0xc7, 0xf3, 0x3b, 0x7a, 0x40, 0xfc,
-	0x40, 0x1d, 0x25, 0x87, 0x3f, 0x86, 0x6b, 0xf1, 0xda, 0x2e, 0x69, 0x39, 0x9a, 0x1f, 0xe8, 0x01,
-	0x61, 0xeb, 0x95, 0xa2, 0x7a, 0x25, 0xc2, 0xe9, 0x24, 0x50, 0x3a, 0x14, 0x03, 0x3f, 0x82, 0x95,
-	0x43, 0xd7, 0x33, 0xe8, 0xca, 0x70, 0x60, 0x19, 0x9a, 0xe1, 0x11, 0x9d, 0x31, 0xba, 0x94, 0x50,
-	0x10, 0x66, 0x18, 0x5d, 0x8a, 0x50, 0x17, 0xed, 0xb8, 0x0d, 0x37, 0xd3, 0xba, 0xf2, 0x5c, 0xdb,
-	0x3e, 0xd0, 0x8d, 0x63, 0x8d, 0x6a, 0x93, 0xb3, 0x40, 0x0c, 0xb6, 0xba, 0x61, 0x79, 0xdd, 0xf5,
-	0xa4, 0x92, 0x54, 0x81, 0xbb, 0x25, 0x50, 0x3b, 0xc4, 0x48, 0xcf, 0x7a, 0x12, 0xe8, 0x6c, 0x99,
-	0x53, 0x9e, 0x3c, 0xeb, 0x49, 0xa0, 0xab, 0xe9, 0x1e, 0xca, 0x26, 0x2c, 0x08, 0xfb, 0xc7, 0x15,
-	0x28, 0x35, 0x5e, 0x1a, 0xf6, 0xd0, 0xb7, 0x4e, 0xc2, 0xc2, 0x16, 0xc3, 0x43, 0x12, 0x5e, 0x84,
-	0xe2, 0x13, 0xdd, 0xb2, 0xdd, 0x13, 0xe2, 0xa1, 0x1c, 0xae, 0x02, 0x6c, 0x93, 0x53, 0x4d, 0xb4,
-	0xe6, 0x95, 0xb7, 0x61, 0x69, 0x44, 0xfa, 0xb4, 0x33, 0x97, 0x3f, 0x3a, 0x47, 0x3b, 0x37, 0x74,
-	0xcf, 0xb6, 0xe8, 0x9b, 0xa4, 0xfc, 0x9b, 0x04, 0xd7, 0x85, 0xf2, 0xa2, 0xc4, 0x8e, 0x98, 0x4c,
-	0x50, 0x51, 0x36, 0x97, 0x3d, 0xf9, 0xd3, 0xb3, 0x2e, 0x37, 0x3a, 0xeb, 0xb2, 0x13, 0x95, 0xfc,
-	0xab, 0x25, 0x2a, 0x85, 0x57, 0x4c, 0x54, 0xe6, 0x26, 0x25, 0x2a, 0xca, 0x9f, 0xe7, 0xe0, 0xcd,
-	0x19, 0xe3, 0x8c, 0xe2, 0xe9, 0x2a, 0x40, 0x94, 0x04, 0xfb, 0x2c, 0x20, 0x54, 0xd4, 0x04, 0x64,
-	0xd6, 0xc8, 0xbf, 0x9f, 0x88, 0xb3, 0x79, 0x36, 0x59, 0x3e, 0xce, 0x9c, 0x2c, 0xb3, 0xf8, 0xb8,
-	0xbb, 0xe3, 0xba, 0xc7, 0xc3, 0x01, 0x73, 0x86, 0x71, 0x44, 0xfe, 0x16, 0xcc, 0x11, 0xcf, 0x73,
-	0x3d, 0x26, 0x9b, 0xf1, 0xda, 0x2a, 0x8b, 0xa7, 0x0d, 0x8a, 0xa0, 0x72, 0x3c, 0x2c, 0xc3, 0x82,
-	0x30, 0x70, 0x21, 0x9e, 0xf0, 0x55, 0xb9, 0x05, 0x10, 0x7f, 0x02, 0x97, 0xa9, 0xe9, 0x19, 0x06,
-	0xf1, 0x7d, 0x6e, 0x6d, 0xd4, 0xc2, 0xa8, 0xb5, 0x29, 0x7f, 0x95, 0x03, 0x2c, 0x58, 0x16, 0xe8,
-	0x4c, 0xff, 0x5f, 0xc8, 0x2a, 0xde, 0x86, 0x0a, 0xd5, 0x17, 0xf5, 0xa8, 0xac, 0x68, 0xc9, 0x04,
-	0x14, 0xc5, 0xa4, 0x74, 0xdb, 0x04, 0x13, 0x2a, 0xbc, 0x9a, 0x09, 0xcd, 0xbd, 0xa2, 0x09, 0xcd,
-	0x4f, 0xcc, 0x75, 0xdf, 0x07, 0x59, 0x37, 0x4f, 0xe8, 0x7a, 0xd3, 0x27, 0xa6, 0x66, 0x5b, 0x7e,
-	0x40, 0x9c, 0x30, 0xa4, 0xf0, 0x04, 0xfc, 0x62, 0xdc, 0xbe, 0x23, 0x9a, 0x69, 0x70, 0x51, 0x7e,
-	0x9a, 0x87, 0x2b, 0xe3, 0x12, 0x8c, 0xec, 0xed, 0x76, 0xb8, 0x5a, 0xa2, 0xda, 0xb3, 0x0c, 0xb2,
-	0xef, 0xd9, 0x22, 0x0d, 0x19, 0x83, 0xe3, 0x7b, 0xb0, 0x3c, 0x0a, 0xeb, 0xda, 0xbe, 0x58, 0xed,
-	0x66, 0x35, 0xe1, 0xf6, 0x98, 0x39, 0x3e, 0xc8, 0x34, 0xc7, 0x0c, 0xce, 0xb2, 0x2d, 0x30, 0xad,
-	0xe2, 0xc2, 0x4c, 0x15, 0xcf, 0x4d, 0x51, 0x71, 0x64, 0xcd, 0xf3, 0xaf, 0x6e, 0xcd, 0x0b, 0x29,
-	0x6b, 0x66, 0x6b, 0x6d, 0xbe, 0x90, 0x3a, 0xf2, 0xdc, 0x61, 0xef, 0x48, 0xf3, 0xb9, 0x18, 0xd8,
-	0x72, 0xaa, 0x98, 0x5e, 0x6b, 0xb3, 0x55, 0x15, 0x47, 0x8b, 0x85, 0xa5, 0x3c, 0x48, 0xcd, 0x87,
-	0x45, 0x28, 0xaa, 0xc4, 0xb4, 0x3c, 0x62, 0x50, 0xaf, 0x59, 0x86, 0x05, 0xb1, 0x0e, 0x40, 0x52,
-	0x62, 0x76, 0xe4, 0x94, 0xff, 0xca, 0xc3, 0x52, 0x38, 0xa1, 0x45, 0xf5, 0x75, 0xc2, 0xd4, 0xb8,
-	0x0e, 0xe5, 0xa8, 0x68, 0x1b, 0xd7, 0x63, 0x43, 0xd0, 0x58, 0x1e, 0x93, 0xcf, 0xc8, 0x63, 0xd2,
-	0x45, 0xdf, 0x82, 0x28, 0x71, 0x24, 0x8b, 0xbe, 0x37, 0xa1, 0x24, 0x0a, 0x76, 0xc4, 0x4c, 0x4b,
-	0x3e, 0x86, 0xa7, 0xd2, 0x8b, 0xf9, 0x33, 0xa6, 0x17, 0x71, 0xde, 0xb0, 0x70, 0x86, 0xbc, 0xe1,
-	0x12, 0xcc, 0x91, 0x81, 0x6b, 0x1c, 0xf1, 0x6a, 0x04, 0x8d, 0x9e, 0xfc, 0x1d, 0xd7, 0xe1, 0xea,
-	0xd0, 0x27, 0x9e, 0x36, 0xf0, 0xdc, 0x13, 0xcb, 0x24, 0xa6, 0x96, 0x1e, 0x52, 0x29, 0x11, 0xb3,
-	0x65, 0x8a, 0xb8, 0x27, 0xf0, 0xf6, 0x92, 0x83, 0xfc, 0x0c, 0x56, 0xa2, 0x6e, 0x3a, 0x73, 0x59,
-	0x5a, 0x9f, 0x66, 0xd9, 0xc0, 0x8c, 0x68, 0x2d, 0xcd, 0x59, 0xd8, 0xb3, 0xc6, 0x10, 0x69, 0x6e,
-	0xfd, 0x58, 0x44, 0x52, 0x15, 0x0f, 0xc6, 0xda, 0xa8, 0x96, 0x78, 0x1e, 0xc1, 0xf9, 0x2f, 0xf3,
-	0x6a, 0x0a, 0x03, 0x35, 0x28, 0x44, 0xf9, 0xe3, 0x1c, 0x94, 0xc3, 0x74, 0x87, 0x38, 0xe6, 0xa8,
-	0x5a, 0xa5, 0x31, 0xb5, 0xce, 0xac, 0xc3, 0xbf, 0x0e, 0x8b, 0xc9, 0x22, 0x72, 0xb8, 0x78, 0xb9,
-	0xaf, 0x96, 0x13, 0xb5, 0x63, 0xfc, 0x76, 0x46, 0x89, 0xb2, 0x10, 0x4a, 0x77, 0xb4, 0x4a, 0xf9,
-	0xd6, 0x78, 0x95, 0x32, 0xaa, 0x4f, 0x9d, 0xad, 0x50, 0x39, 0x3f, 0xa5, 0x50, 0xb9, 0x06, 0x45,
-	0xcb, 0xe7, 0xc5, 0x43, 0x66, 0x0d, 0x91, 0x8d, 0x2d, 0x58, 0x3e, 0xab, 0x1b, 0x2a, 0x7f, 0x23,
-	0x45, 0x31, 0x83, 0x0a, 0x49, 0x25, 0x06, 0xb1, 0x06, 0xc1, 0x2f, 0x41, 0x56, 0x8f, 0x01, 0x12,
-	0xa9, 0x75, 0x7e, 0x76, 0x6a, 0x5d, 0xea, 0x47, 0x59, 0xf5, 0x84, 0x91, 0x16, 0x26, 0x8f, 0x54,
-	0xf9, 0xc3, 0xb8, 0x0c, 0x40, 0xc7, 0xc1, 0x5c, 0xd1, 0x2f, 0x61, 0x14, 0x91, 0xdb, 0xcb, 0x67,
-	0x6e, 0x90, 0x4e, 0x75, 0x7b, 0x05, 0xe6, 0x53, 0xa2, 0x20, 0xfe, 0x63, 0x29, 0xaa, 0x94, 0x89,
-	0x81, 0x8f, 0x2e, 0x98, 0xa4, 0xb1, 0x05, 0x53, 0x5a, 0x88, 0x94, 0xbd, 0xb3, 0x0b, 0xf1, 0x1d,
-	0x40, 0x1e, 0x11, 0xd5, 0xf5, 0x53, 0xcd, 0x70, 0x87, 0x4e, 0xc0, 0xd4, 0xc0, 0x36, 0x48, 0x96,
-	0xe2, 0xa6, 0x3a, 0x6d, 0x49, 0x6e, 0x01, 0x16, 0x92, 0x5b, 0x80, 0xca, 0x2f, 0x0a, 0x00, 0x61,
-	0xa9, 0xc0, 0x38, 0x9e, 0xcd, 0xf2, 0x87, 0x50, 0xa4, 0x84, 0x58, 0xf9, 0x3e, 0xc7, 0x84, 0xb6,
-	0x96, 0x19, 0xc5, 0x6a, 0xc6, 0xf1, 0xdd, 0x9a, 0x71, 0xcc, 0x57, 0x90, 0x3a, 0x7f, 0x18, 0x33,
-	0x9a, 0xfc, 0x2b, 0x8c, 0xb7, 0x03, 0xe8, 0x44, 0xb7, 0x2d, 0x93, 0x2f, 0x08, 0x92, 0xa9, 0xd7,
-	0xfa, 0x44, 0x06, 0x9e, 0x47, 0x1d, 0xb8, 0x12, 0x97, 0x4e, 0xd2, 0x00, 0xca, 0xd0, 0xd8, 0x86,
-	0xf7, 0x95, 0x31, 0x17, 0x1c, 0xed, 0x66, 0xa6, 0x2a, 0xdd, 0x59, 0x7e, 0x60, 0xfe, 0x15, 0xfc,
-	0xc0, 0xc2, 0x04, 0x3f, 0x90, 0x8e, 0x3e, 0xbc, 0x8a, 0x1c, 0x47, 0x1f, 0xe5, 0x2d, 0x58, 0x10,
-	0x72, 0xa5, 0x0b, 0x8e, 0xa6, 0x63, 0x5a, 0x27, 0x96, 0x39, 0xd4, 0x6d, 0x74, 0x8e, 0xbe, 0xd7,
-	0x87, 0xfd, 0xa1, 0xcd, 0xb7, 0xa1, 0x25, 0xe5, 0x77, 0x25, 0x58, 0x1a, 0x11, 0x01, 0x5e, 0x85,
-	0x2b, 0xfb, 0x23, 0x9b, 0x67, 0x75, 0xd7, 0xf3, 0x86, 0x6c, 0x1d, 0x87, 0xce, 0xe1, 0x8b, 0x80,
-	0xb7, 0x48, 0x62, 0x27, 0x8e, 0xf5, 0x42, 0x12, 0x5e, 0x01, 0x54, 0x3f, 0x22, 0xc6, 0xb1, 0x3f,
-	0xec, 0xef, 0x5a, 0x7e, 0x5f, 0x0f, 0x8c, 0x23, 0x94, 0xc3, 0x97, 0xe1, 0x02, 0xdb, 0x49, 0xdb,
-	0x22, 0x1d, 0xe2, 0x59, 0xba, 0x6d, 0x7d, 0x4e, 0x78, 0x87, 0x3c, 0x5e, 0x86, 0xa5, 0x2d, 0x12,
-	0xee, 0x58, 0x71, 0x60, 0x41, 0xf9, 0x9f, 0xd8, 0x1d, 0xd5, 0x8c, 0xe3, 0x28, 0xf1, 0x9a, 0x69,
-	0x75, 0x59, 0xb2, 0xce, 0xbd, 0x82, 0xac, 0xf3, 0x13, 0x64, 0xfd, 0xcb, 0x4b, 0xe2, 0x47, 0xd4,
-	0x36, 0x3f, 0xaa, 0xb6, 0x03, 0xb8, 0x1a, 0x0d, 0x9c, 0xaa, 0xa7, 0x2e, 0x06, 0x57, 0x3f, 0x62,
-	0x5b, 0xde, 0x33, 0x25, 0xa0, 0x40, 0xc9, 0xf2, 0x35, 0x9d, 0xf5, 0x4d, 0x97, 0x4f, 0x8b, 0x96,
-	0xcf, 0x49, 0x2a, 0xcf, 0xa3, 0x80, 0xf8, 0xc4, 0x76, 0x5f, 0xcc, 0xa6, 0xf9, 0x06, 0x54, 0x05,
-	0xf7, 0x7b, 0xc4, 0xeb, 0x73, 0x99, 0xe6, 0xd6, 0x2b, 0xea, 0x08, 0x54, 0xe9, 0x46, 0x4a, 0xdb,
-	0x77, 0xfc, 0xa8, 0x14, 0x35, 0x93, 0xfc, 0xf4, 0x25, 0x88, 0xf2, 0x17, 0x52, 0x22, 0x7e, 0x93,
-	0xe3, 0x2f, 0x4b, 0xef, 0x4b, 0x45, 0xa4, 0x7b, 0xb0, 0x12, 0xf6, 0x4d, 0xed, 0xc4, 0xb3, 0x90,
-	0xa4, 0xe2, 0x50, 0x1e, 0xf1, 0x86, 0xbc, 0xf2, 0x21, 0xc8, 0x82, 0x79, 0x95, 0xe8, 0xc6, 0x11,
-	0x31, 0x1b, 0x8e, 0xd9, 0x3e, 0xec, 0x86, 0x09, 0xe6, 0xd4, 0x91, 0x28, 0xcf, 0xa3, 0xf2, 0x6c,
-	0xdd, 0x76, 0x7d, 0x12, 0xe5, 0xab, 0x33, 0x03, 0xda, 0x0c, 0x91, 0x8e, 0xd0, 0x0d, 0x6d, 0xec,
-	0x4b, 0xab, 0xea, 0xb7, 0x25, 0x78, 0x23, 0x1a, 0xad, 0x08, 0x2c, 0xfb, 0x8e, 0x6e, 0x1c, 0x3b,
-	0xee, 0x0b, 0x76, 0x8c, 0xc5, 0x8c, 0xb2, 0xa3, 0x99, 0x9f, 0xfa, 0x08, 0xca, 0xb1, 0x9a, 0xa8,
-	0xc5, 0xcd, 0x0c, 0x02, 0x10, 0xe9, 0xc9, 0x57, 0x7e, 0x10, 0x05, 0x59, 0xb1, 0x46, 0x1e, 0x61,
-	0x5d, 0x1a, 0xb5, 0x8a, 0x38, 0x5d, 0xce, 0xcd, 0x4e, 0x97, 0x95, 0xff, 0x95, 0xe0, 0xe2, 0xc8,
-	0x22, 0xe2, 0x8c, 0xdf, 0x19, 0x5b, 0x14, 0xe4, 0x32, 0x4e, 0x82, 0xbc, 0x03, 0xc8, 0xd6, 0x47,
-	0xb2, 0x1e, 0x6a, 0xa8, 0x79, 0x76, 0x64, 0xa7, 0x4a, 0xdb, 0x12, 0xd9, 0xdd, 0xf8, 0x06, 0x7f,
-	0x21, 0x6b, 0x83, 0x7f, 0x24, 0x51, 0x9e, 0x1b, 0x4d, 0x94, 0xf1, 0xdb, 0x50, 0x8d, 0x58, 0xf3,
-	0x88, 0x6e, 0x9e, 0x8a, 0x1d, 0x4f, 0x9e, 0xdd, 0x47, 0x6c, 0xab, 0xb4, 0x49, 0x79, 0x09, 0x8b,
-	0x42, 0x00, 0x3c, 0x5e, 0xcc, 0x18, 0x76, 0xe4, 0x40, 0x73, 0xaf, 0x9e, 0x40, 0xe5, 0xd3, 0x09,
-	0x54, 0x25, 0x72, 0x07, 0x7b, 0x96, 0xd3, 0x4b, 0xbe, 0xba, 0x4e, 0x2f, 0x69, 0xda, 0xc2, 0x96,
-	0x3a, 0x81, 0x1e, 0xcc, 0x54, 0xcb, 0xac, 0x9a, 0xb5, 0xf2, 0xdf, 0x05, 0xb8, 0x96, 0x45, 0x58,
-	0xcd, 0x5e, 0x65, 0x8f, 0x7d, 0xe0, 0x7d, 0x00, 0x36, 0x30, 0xcd, 0xa0, 0x0b, 0x9f, 0xdc, 0xac,
-	0x30, 0x52, 0x62, 0xc8, 0x75, 0xba, 0xc0, 0xb9, 0x09, 0x15, 0xde, 0x33, 0x96, 0x07, 0x5b, 0x46,
-	0x32, 0x60, 0x98, 0x42, 0xae, 0x02, 0xf4, 0xfd, 0x9e, 0xaa, 0x07, 0xa4, 0x2d, 0xf6, 0xc0, 0x25,
-	0x35, 0x01, 0xc1, 0xb7, 0x01, 0xf5, 0xfd, 0x9e, 0x58, 0x42, 0x0f, 0x86, 0x01, 0xc5, 0x9a, 0x63,
-	0x58, 0x63, 0x70, 0x81, 0x4b, 0x7b, 0x46, 0x93, 0x98, 0x59, 0x02, 0xc7, 0x4d, 0xc1, 0xb1, 0x02,
-	0xa9, 0xb2, 0xbc, 0x58, 0xe3, 0xa7, 0x4b, 0xf5, 0xb7, 0x01, 0xe9, 0x27, 0xba, 0x65, 0xeb, 0x07,
-	0x76, 0x14, 0x40, 0x78, 0xba, 0x32, 0x06, 0xc7, 0xeb, 0xb0, 0x34, 0xa4, 0x0e, 0x23, 0xf6, 0x14,
-	0x6c, 0x89, 0x59, 0x50, 0x47, 0xc1, 0x78, 0x13, 0xae, 0x1d, 0xd8, 0x2e, 0x05, 0x85, 0xfa, 0x68,
-	0x3b, 0xfb, 0x02, 0xc7, 0x17, 0x1b, 0x9b, 0x45, 0x75, 0x2a, 0x0e, 0x35, 0x32, 0xdd, 0x34, 0x69,
-	0x06, 0xc3, 0xd6, 0x8d, 0x25, 0x35, 0x7c, 0xa5, 0x21, 0xcf, 0x08, 0xb7, 0x11, 0x3b, 0x96, 0x63,
-	0xf0, 0x53, 0x3f, 0x25, 0x75, 0x04, 0x8a, 0xb1, 0x38, 0x38, 0x59, 0xe1, 0x87, 0x3b, 0xd8, 0xe9,
-	0x48, 0x1a, 0x2e, 0xb9, 0x9c, 0x1a, 0x2f, 0x07, 0x96, 0x47, 0x4c, 0x56, 0x13, 0x97, 0xd4, 0x11,
-	0xa8, 0xd0, 0xd9, 0xa6, 0x6e, 0x1c, 0xdb, 0x6e, 0x8f, 0x55, 0xbf, 0x0b, 0x6a, 0x02, 0xa2, 0x7c,
-	0x0a, 0x97, 0x84, 0xc5, 0x3d, 0x25, 0xc1, 0x8e, 0xee, 0x27, 0xf6, 0x1b, 0xbe, 0xac, 0xa3, 0x4e,
-	0x54, 0x91, 0x47, 0x69, 0x47, 0x06, 0x5d, 0x87, 0x25, 0xe6, 0x84, 0x12, 0xc1, 0x52, 0x9a, 0xbd,
-	0xf2, 0xa8, 0xd8, 0x29, 0x46, 0x67, 0xc4, 0xe2, 0x5f, 0x87, 0xd7, 0xa2, 0x71, 0xf4, 0x75, 0xef,
-	0x58, 0x33, 0x89, 0x4d, 0x02, 0xa2, 0x0d, 0xc2, 0xcd, 0x8b, 0x33, 0x84, 0xe7, 0x2b, 0x21, 0x85,
-	0x5d, 0xdd, 0x3b, 0xde, 0x62, 0xfd, 0xc3, 0x3a, 0xba, 0xf2, 0x33, 0x29, 0x4a, 0xa7, 0x9e, 0x92,
-	0x80, 0x45, 0x5d, 0xbf, 0x7d, 0x48, 0xad, 0xd2, 0x1f, 0xe8, 0xc6, 0xcc, 0x49, 0x7b, 0x0d, 0x4a,
-	0x4e, 0x88, 0x2b, 0x1c, 0x75, 0x0c, 0xc0, 0x2d, 0x28, 0xb0, 0x2a, 0x46, 0x7e, 0xca, 0x06, 0x4b,
-	0xd6, 0x57, 0xef, 0xb2, 0x9a, 0x06, 0xec, 0x35, 0xd4, 0x4e, 0xb3, 0xd3, 0x6d, 0xb4, 0xba, 0x2a,
-	0xa3, 0xa3, 0x3c, 0x80, 0x02, 0xab, 0x68, 0x54, 0x21, 0xd1, 0x86, 0xce, 0x61, 0x0c, 0xd5, 0x56,
-	0xbb, 0xa5, 0x25, 0x60, 0x12, 0x5e, 0x80, 0x7c, 0x6d, 0x67, 0x07, 0xe5, 0x94, 0xef, 0xc3, 0xcd,
-	0x29, 0x9f, 0x3a, 0xab, 0x77, 0xba, 0x08, 0xf3, 0x2c, 0x10, 0xf0, 0x38, 0x5b, 0x52, 0xc5, 0x9b,
-	0xe2, 0x44, 0xab, 0xe9, 0xa7, 0x24, 0x10, 0x07, 0x8c, 0x67, 0x90, 0x8a, 0x6a, 0x69, 0xb9, 0x64,
-	0x2d, 0x6d, 0x3c, 0x46, 0xe5, 0x33, 0x62, 0x94, 0xf2, 0x9f, 0x52, 0x94, 0x2e, 0x45, 0x1f, 0xfc,
-	0x9a, 0x78, 0xd8, 0x38, 0x41, 0x28, 0x9c, 0xa1, 0x9e, 0x36, 0x3e, 0xde, 0xb9, 0xac, 0xf1, 0xfe,
-	0x46, 0x72, 0xb8, 0x6d, 0x8f, 0x6d, 0x75, 0x91, 0x2f, 0x23, 0xe7, 0x98, 0xcb, 0x3c, 0x9b, 0xab,
-	0xd3, 0xd3, 0x98, 0xbf, 0x93, 0x60, 0x6d, 0xd2, 0xf7, 0xbf, 0x26, 0x62, 0x3f, 0x5b, 0x72, 0xa3,
-	0xfc, 0x08, 0x2a, 0x62, 0x20, 0x2d, 0xf2, 0xa2, 0xfb, 0xd2, 0x99, 0xc5, 0x35, 0x5f, 0xfb, 0x69,
-	0x41, 0x60, 0x6b, 0x3e, 0x31, 0x5c, 0xc7, 0x4c, 0xac, 0x13, 0xe9, 0xda, 0xaf, 0x1b, 0xd8, 0x1d,
-	0x0e, 0xc7, 0x17, 0x61, 0x2e, 0x30, 0xc2, 0x0c, 0x8c, 0x21, 0x14, 0x02, 0xa3, 0x69, 0x2a, 0x3f,
-	0x95, 0xe0, 0x42, 0xea, 0x9b, 0x67, 0x95, 0xd8, 0xd7, 0x7f, 0x91, 0xaa, 0xfc, 0x59, 0x3c, 0x0f,
-	0x6b, 0x66, 0xbc, 0xf1, 0xd5, 0x75, 0xcf, 0x20, 0xda, 0xff, 0xaf, 0xe1, 0xa5, 0x77, 0xf9, 0x0a,
-	0xcc, 0x4f, 0x25, 0x20, 0xca, 0xbf, 0xc6, 0xc6, 0x3c, 0xc6, 0xf3, 0x37, 0x48, 0x35, 0xcf, 0x60,
-	0x31, 0xb9, 0xa5, 0xfe, 0xc5, 0x4f, 0x7a, 0x28, 0xff, 0x14, 0x07, 0xc7, 0x9a, 0x69, 0x26, 0x89,
-	0x7e, 0xa5, 0x7a, 0xfe, 0x95, 0x11, 0xd6, 0x0b, 0x59, 0xd5, 0xb6, 0x24, 0xb7, 0x23, 0xc3, 0xfa,
-	0x0f, 0x29, 0x0a, 0x89, 0x59, 0xc3, 0xfa, 0x06, 0x99, 0xc2, 0x5f, 0x4a, 0x91, 0xd7, 0x6b, 0x38,
-	0xe6, 0x57, 0xa8, 0xb2, 0x47, 0x00, 0xd4, 0x9b, 0xea, 0x46, 0x10, 0x3a, 0xe8, 0xea, 0xc6, 0xa5,
-	0xf4, 0xc0, 0xba, 0x2f, 0x9d, 0x1a, 0x6b, 0x56, 0x4b, 0x41, 0xf8, 0x98, 0x74, 0xa1, 0x7c, 0x00,
-	0xdf, 0x20, 0xe5, 0xfc, 0x49, 0x2e, 0x72, 0xa1, 0x7c, 0x6c, 0x6d, 0x27, 0xf2, 0x49, 0x5f, 0xd5,
-	0xf0, 0x22, 0x5f, 0xc1, 0x77, 0x22, 0x85, 0xaf, 0x48, 0x6b, 0x6f, 0xee, 0xac, 0xda, 0xc3, 0x4f,
-	0xe1, 0xc6, 0x28, 0x97, 0x9a, 0x7b, 0xa8, 0xd9, 0xee, 0x0b, 0xed, 0x85, 0x1e, 0x10, 0x8f, 0x26,
-	0xf4, 0xa2, 0xc0, 0x79, 0x2d, 0xcd, 0x73, 0xfb, 0x70, 0xc7, 0x7d, 0xf1, 0x49, 0x88, 0x93, 0xf4,
-	0xdc, 0x63, 0xa2, 0xfa, 0x06, 0x59, 0xc4, 0x3f, 0xe7, 0x22, 0x7f, 0x1b, 0x0e, 0x33, 0xe5, 0xc9,
-	0xbf, 0x36, 0xfe, 0x56, 0x7a, 0x15, 0x7f, 0xfb, 0xd5, 0x9b, 0x4f, 0xc2, 0xe1, 0x67, 0xc9, 0xf5,
-	0x1b, 0x64, 0x41, 0x3f, 0x59, 0x87, 0xf2, 0xa6, 0xee, 0x13, 0x31, 0x5a, 0xbc, 0x21, 0xaa, 0x0f,
-	0xfc, 0xb4, 0xea, 0x6a, 0x9a, 0x72, 0x02, 0x31, 0x7d, 0x77, 0x73, 0x41, 0xd4, 0x30, 0x44, 0xa5,
-	0xf3, 0x5a, 0xe6, 0xc2, 0x55, 0x1c, 0x9e, 0x50, 0x43, 0x64, 0xfc, 0x11, 0x94, 0xa2, 0xda, 0x87,
-	0x58, 0x96, 0xaf, 0x4e, 0xeb, 0x49, 0x4c, 0x35, 0xee, 0x40, 0x7b, 0x47, 0x3b, 0x02, 0xc2, 0xc2,
-	0x56, 0xa7, 0x9f, 0x48, 0x54, 0xe3, 0x0e, 0xf8, 0x03, 0x28, 0x86, 0xd5, 0x47, 0x26, 0x92, 0x72,
-	0xc6, 0x4d, 0xb1, 0x64, 0x2d, 0x56, 0x8d, 0xd0, 0xf1, 0x1d, 0x28, 0xf8, 0xc4, 0xe1, 0x1b, 0x2d,
-	0xe5, 0x51, 0xe1, 0x27, 0x77, 0xbc, 0x19, 0x1a, 0xae, 0xc3, 0x22, 0xfd, 0xd5, 0x3c, 0xbe, 0x01,
-	0x2e, 0xce, 0x4e, 0xac, 0x4d, 0xee, 0xc6, 0xf1, 0xd4, 0xb2, 0x9f, 0xd8, 0x35, 0xff, 0x36, 0x00,
-	0x23, 0xc2, 0xd5, 0x5e, 0x9c, 0x36, 0xda, 0x70, 0x8f, 0x5a, 0x2d, 0xf9, 0xd1, 0x76, 0xf5, 0xa3,
-	0x58, 0xff, 0xa5, 0x29, 0x1a, 0x0a, 0x0f, 0x36, 0x46, 0x3b, 0x4b, 0xb7, 0x21, 0xaf, 0x1b, 0xc7,
-	0xe2, 0x44, 0xbf, 0x3c, 0x69, 0xb3, 0x53, 0xa5, 0x48, 0x54, 0x2c, 0x87, 0xb6, 0xfb, 0x82, 0x95,
-	0xbd, 0x26, 0x89, 0xe5, 0x89, 0xed, 0xbe, 0x50, 0x19, 0x1a, 0xde, 0x84, 0xf2, 0x30, 0xde, 0xd2,
-	0x11, 0x27, 0x51, 0xb3, 0xa5, 0x92, 0xd8, 0xfa, 0x51, 0x93, 0x9d, 0xe8, 0xb0, 0x7c, 0x5e, 0x23,
-	0x67, 0xd5, 0xb2, 0x49, 0xc3, 0x12, 0x75, 0x74, 0x35, 0x44, 0xc6, 0xf7, 0xc2, 0xf9, 0x53, 0xcd,
-	0x72, 0x4c, 0xc9, 0x22, 0x74, 0x38, 0x81, 0x9a, 0x50, 0x35, 0x6c, 0xd7, 0x27, 0xd1, 0x59, 0x15,
-	0x56, 0x5c, 0x2b, 0x6f, 0x28, 0xd9, 0xf6, 0x9a, 0xdc, 0x5a, 0x51, 0x2b, 0x46, 0x6a, 0xa7, 0x25,
-	0x22, 0x15, 0x16, 0x99, 0xd8, 0xf1, 0xd2, 0xa9, 0xa4, 0xc2, 0x52, 0xa3, 0x20, 0x15, 0x6d, 0xae,
-	0xb4, 0xd9, 0xb1, 0x77, 0x5e, 0x5e, 0x0f, 0x05, 0xc1, 0x0f, 0x9c, 0xbe, 0x3e, 0xd5, 0x98, 0x43,
-	0x81, 0x2c, 0x0d, 0x46, 0x76, 0x1a, 0xee, 0x40, 0x61, 0x60, 0x39, 0x3d, 0x76, 0xcf, 0x6e, 0x92,
-	0x0e, 0xf7, 0x2c, 0xa7, 0xa7, 0x32, 0x34, 0x86, 0xee, 0x3a, 0x3d, 0x76, 0xe1, 0x6e, 0x22, 0xba,
-	0xcb, 0xd0, 0x5d, 0xa7, 0x87, 0x7f, 0x13, 0xae, 0x7b, 0xd3, 0xf7, 0x70, 0xd8, 0xb5, 0xbc, 0xf2,
-	0xc6, 0xc3, 0x4c, 0x4a, 0x33, 0xf6, 0x7f, 0xd4, 0x59, 0xc4, 0xf1, 0xaf, 0xc1, 0xf9, 0x68, 0x71,
-	0x17, 0x9e, 0xb4, 0x94, 0x2f, 0xb0, 0x2f, 0xde, 0x79, 0xb5, 0xe3, 0x99, 0xe3, 0x74, 0xb0, 0x9f,
-	0xb8, 0xe7, 0x35, 0x7a, 0x8c, 0x93, 0xdd, 0x23, 0x2c, 0x6f, 0xbc, 0xfb, 0x85, 0xce, 0x80, 0xaa,
-	0x93, 0xe9, 0xd2, 0x49, 0x64, 0xc7, 0x67, 0xf6, 0xd8, 0x2d, 0xc4, 0x49, 0x93, 0x28, 0x79, 0xb6,
-	0x2f, 0xd9, 0x09, 0x7f, 0x06, 0xcb, 0xf6, 0xf8, 0xb9, 0x3f, 0x76, 0x4b, 0xb1, 0x3c, 0xe1, 0x80,
-	0x43, 0xc6, 0x39, 0x41, 0x35, 0x8b, 0x08, 0x7e, 0x16, 0x9f, 0xbb, 0x67, 0x5b, 0x1b, 0xec, 0x46,
-	0xe3, 0x44, 0x53, 0x4f, 0x6d, 0x82, 0xa4, 0x3b, 0xe2, 0x1f, 0xc2, 0x05, 0x23, 0x6b, 0x93, 0x84,
-	0xdd, 0x81, 0x2c, 0x6f, 0xdc, 0x3e, 0x03, 0xc5, 0x90, 0xd3, 0x6c, 0x42, 0xb8, 0x0b, 0xe7, 0xbd,
-	0xd1, 0xfd, 0x54, 0x76, 0x7d, 0xb2, 0x3c, 0xe1, 0xbe, 0xc2, 0xd8, 0xee, 0xab, 0x3a, 0x4e, 0x80,
-	0x07, 0x0b, 0x72, 0xcc, 0xee, 0x55, 0x4e, 0x0e, 0x16, 0xe4, 0x58, 0x65, 0x68, 0xf8, 0x7b, 0x80,
-	0x7a, 0x23, 0xd5, 0x73, 0x76, 0xdb, 0xb2, 0xbc, 0x71, 0x6b, 0x52, 0x31, 0x38, 0x5d, 0x6a, 0x1f,
-	0xeb, 0x8e, 0x2d, 0x90, 0x7b, 0x13, 0x0a, 0xf2, 0xec, 0x8e, 0xe6, 0x24, 0xe3, 0x9f, 0x54, 0xc5,
-	0x57, 0x27, 0x92, 0xc3, 0x1a, 0x5c, 0xe4, 0xc7, 0x04, 0x22, 0xdf, 0xa6, 0x19, 0xec, 0x90, 0x81,
-	0x7c, 0x9d, 0x7d, 0xe8, 0xad, 0x09, 0x11, 0x64, 0xfc, 0x54, 0x82, 0xba, 0xa2, 0x67, 0x9d, 0x55,
-	0xf8, 0x01, 0xac, 0xf4, 0x32, 0x6a, 0xd2, 0xf2, 0xda, 0x14, 0xf2, 0x99, 0x45, 0xec, 0x4c, 0x32,
-	0x78, 0x08, 0xd7, 0x7a, 0x53, 0x4a, 0xde, 0xf2, 0x0d, 0xf6, 0x99, 0xfb, 0x67, 0xff, 0x4c, 0x28,
-	0xb2, 0xa9, 0x64, 0x69, 0x26, 0xd3, 0x0b, 0x4b, 0xd3, 0xb2, 0x32, 0x25, 0xb6, 0xc7, 0x05, 0xec,
-	0xb8, 0x03, 0xb5, 0xdb, 0xde, 0x68, 0x61, 0x5b, 0xbe, 0x39, 0xc5, 0x6e, 0xc7, 0xca, 0xe0, 0xea,
-	0x38, 0x01, 0x3a, 0x73, 0xf5, 0xe4, 0xfd, 0x2d, 0xf9, 0xf5, 0x29, 0x33, 0x37, 0x75, 0xd3, 0x4b,
-	0x4d, 0x77, 0xc4, 0x0d, 0x58, 0xd4, 0x13, 0x57, 0xd5, 0xe4, 0x5b, 0x8c, 0xd0, 0x8d, 0x89, 0x84,
-	0x22, 0xae, 0x52, 0xdd, 0xa8, 0xab, 0xd3, 0xe3, 0x73, 0x3b, 0xf2, 0x1b, 0x53, 0x5c, 0x5d, 0xe2,
-	0x7c, 0x8f, 0x9a, 0xec, 0x24, 0x44, 0x95, 0x2e, 0x4a, 0xcb, 0x6f, 0x4e, 0x17, 0xd5, 0x48, 0x09,
-	0x7b, 0x9c, 0x00, 0xb6, 0xe1, 0x72, 0x6f, 0x52, 0xa9, 0x5b, 0x5e, 0x67, 0xd4, 0xef, 0x9e, 0x91,
-	0x7a, 0xe4, 0xf2, 0x27, 0x12, 0xc4, 0x0f, 0x60, 0xde, 0x61, 0xb5, 0x61, 0x79, 0x23, 0x6b, 0x23,
-	0x2b, 0x5d, 0x3e, 0x16, 0xa8, 0x78, 0x1b, 0xaa, 0x4e, 0xaa, 0xa0, 0x2c, 0x3f, 0x60, 0x9d, 0x6f,
-	0x4e, 0xeb, 0x1c, 0x32, 0x33, 0xd2, 0x95, 0x4a, 0x51, 0x1f, 0xad, 0x86, 0xca, 0x0f, 0xa7, 0x48,
-	0x71, 0xbc, 0x76, 0x3a, 0x4e, 0x80, 0x4a, 0x51, 0x9f, 0x54, 0x63, 0x95, 0xdf, 0x9d, 0x22, 0xc5,
-	0x89, 0x95, 0x59, 0x75, 0x32, 0x41, 0xea, 0x48, 0xf4, 0x8c, 0x4a, 0x9e, 0xfc, 0x68, 0x9a, 0x9f,
-	0xca, 0x2a, 0xfd, 0x65, 0x92, 0xa1, 0x8e, 0x44, 0x9f, 0x52, 0x28, 0x94, 0xdf, 0x9b, 0xe2, 0x48,
-	0xa6, 0x55, 0x18, 0xd5, 0xa9, 0x64, 0xa9, 0x6d, 0x10, 0xb6, 0x5c, 0x95, 0xdf, 0x9f, 0x62, 0x1b,
-	0xa2, 0x2e, 0x26, 0x50, 0xa9, 0x6d, 0x90, 0x54, 0xa5, 0x4c, 0xfe, 0x60, 0x8a, 0x6d, 0xa4, 0x8b,
-	0x6a, 0xea, 0x48, 0x57, 0x6a, 0x1b, 0x64, 0xb4, 0xde, 0x22, 0x3f, 0x9e, 0x62, 0x1b, 0xe3, 0xd5,
-	0x99, 0x71, 0x02, 0xd4, 0x36, 0xc8, 0xa4, 0x2a, 0x8e, 0xfc, 0xe1, 0x14, 0xdb, 0x98, 0x58, 0xfb,
-	0x51, 0x27, 0x13, 0xa4, 0xb6, 0x41, 0x32, 0x16, 0xfd, 0xf2, 0x47, 0x53, 0x6c, 0x23, 0xb3, 0x4a,
-	0x90, 0x49, 0x86, 0xda, 0x06, 0x99, 0x52, 0x53, 0x90, 0xbf, 0x3d, 0xc5, 0x36, 0xa6, 0x15, 0x23,
-	0xd4, 0xa9, 0x64, 0x95, 0x9f, 0x15, 0xc5, 0x1f, 0x25, 0x95, 0x61, 0xa1, 0xde, 0x6e, 0xb5, 0x1a,
-	0xf5, 0x2e, 0xca, 0xe1, 0x0a, 0x94, 0xc4, 0x4b, 0x63, 0x0b, 0xe5, 0xe9, 0x6b, 0x67, 0x7f, 0xb3,
-	0x53, 0x57, 0x9b, 0x9b, 0x0d, 0x54, 0x60, 0xff, 0x99, 0xa4, 0xb6, 0xb7, 0xf6, 0xeb, 0x0d, 0x95,
-	0xff, 0x3f, 0x52, 0xa7, 0xd1, 0xda, 0x42, 0xf3, 0x18, 0xc1, 0x22, 0x7d, 0xd2, 0xd4, 0x46, 0xbd,
-	0xd1, 0xdc, 0xeb, 0xa2, 0x05, 0x5c, 0x05, 0x60, 0x90, 0x86, 0xaa, 0xb6, 0x55, 0x54, 0xa4, 0x1f,
-	0xd9, 0x6d, 0x74, 0x3a, 0xb5, 0xa7, 0x0d, 0x54, 0x62, 0x3b, 0xcb, 0xf5, 0x6d, 0x04, 0x94, 0xc2,
-	0x93, 0x9d, 0xf6, 0x27, 0xa8, 0x8c, 0x97, 0xa0, 0xbc, 0xdf, 0x8a, 0x3f, 0xb5, 0xc8, 0xae, 0x1e,
-	0xed, 0xd7, 0xeb, 0x8d, 0x4e, 0x07, 0x55, 0x70, 0x09, 0xe6, 0x38, 0xa1, 0x2a, 0xc6, 0x50, 0xad,
-	0xef, 0xb4, 0x3b, 0x0d, 0x2d, 0x62, 0x64, 0x29, 0x86, 0xd5, 0xdb, 0xad, 0xce, 0xfe, 0x6e, 0x43,
-	0x45, 0x08, 0xaf, 0x00, 0x0a, 0x31, 0xb4, 0x90, 0xd0, 0x79, 0xfa, 0xc1, 0xbd, 0x66, 0xeb, 0x29,
-	0xc2, 0xec, 0xa9, 0xdd, 0x7a, 0x8a, 0x96, 0xf1, 0x2d, 0xb8, 0xa1, 0x36, 0xb6, 0x1a, 0x3b, 0xcd,
-	0xe7, 0x0d, 0x55, 0xdb, 0x6f, 0xd5, 0xea, 0xdb, 0xad, 0xf6, 0x27, 0x3b, 0x8d, 0xad, 0xa7, 0x8d,
-	0x2d, 0x4d, 0xf0, 0xdc, 0x41, 0x2b, 0x58, 0x86, 0x95, 0xbd, 0x9a, 0xda, 0x6d, 0x76, 0x9b, 0xed,
-	0x16, 0x6b, 0xe9, 0xd6, 0xb6, 0x6a, 0xdd, 0x1a, 0xba, 0x80, 0x6f, 0xc0, 0x6b, 0x59, 0x2d, 0x9a,
-	0xda, 0xe8, 0xec, 0xb5, 0x5b, 0x9d, 0x06, 0xba, 0xc8, 0x2e, 0xdb, 0xb5, 0xdb, 0xdb, 0xfb, 0x7b,
-	0xe8, 0x12, 0x5e, 0x86, 0x25, 0xfe, 0x1c, 0x23, 0xc8, 0x6c, 0x08, 0x82, 0x79, 0xad, 0xd3, 0xad,
-	0x75, 0x3b, 0xe8, 0x32, 0xbe, 0x0a, 0x97, 0xd2, 0xb0, 0xb8, 0xc3, 0x15, 0xca, 0x8e, 0xda, 0xa8,
-	0xd5, 0x9f, 0x35, 0xb6, 0x34, 0x2a, 0xe7, 0xf6, 0x13, 0xad, 0xdb, 0xde, 0x6b, 0xd6, 0xd1, 0x55,
-	0xae, 0x96, 0xc6, 0x36, 0xba, 0x86, 0x2f, 0xc1, 0xf2, 0xd3, 0x46, 0x57, 0xdb, 0xa9, 0x75, 0xba,
-	0xe1, 0x48, 0xb4, 0xe6, 0x16, 0x7a, 0x0d, 0xaf, 0xc1, 0xb5, 0x8c, 0x86, 0x98, 0xfc, 0x2a, 0xbe,
-	0x02, 0x17, 0x6b, 0xf5, 0x6e, 0xf3, 0x79, 0x2c, 0x53, 0xad, 0xfe, 0xac, 0xd6, 0x7a, 0xda, 0x40,
-	0xd7, 0x29, 0x5f, 0xb4, 0x37, 0xfb, 0x5e, 0x87, 0x7e, 0xb9, 0x55, 0xdb, 0x6d, 0x74, 0xf6, 0x6a,
-	0xf5, 0x06, 0x5a, 0xc3, 0xaf, 0xc3, 0xda, 0x84, 0xc6, 0x98, 0xfc, 0x0d, 0x6a, 0x1e, 0x14, 0xab,
-	0x53, 0x7f, 0xd6, 0xd8, 0xad, 0x21, 0x25, 0xe4, 0x94, 0xbf, 0xc7, 0x88, 0x37, 0xa9, 0x5c, 0x6a,
-	0xfb, 0xdd, 0x67, 0xf4, 0xe3, 0x3b, 0x3b, 0x0d, 0xfa, 0xfd, 0xd7, 0xf1, 0x79, 0xa8, 0x30, 0x58,
-	0x84, 0x76, 0x8b, 0x1a, 0x60, 0xad, 0xbe, 0x1d, 0x43, 0xde, 0xa0, 0xf2, 0xa1, 0x14, 0xdb, 0xaa,
-	0x56, 0x57, 0x1b, 0xb5, 0x6e, 0x23, 0xfc, 0xd6, 0x9b, 0x54, 0x5d, 0x59, 0x2d, 0x71, 0xe7, 0x75,
-	0x6a, 0x7c, 0xad, 0xc6, 0x27, 0x5a, 0xf7, 0x57, 0x5b, 0x68, 0x83, 0x5a, 0x92, 0x78, 0x89, 0x51,
-	0x1e, 0x50, 0xfa, 0xb5, 0xad, 0x2d, 0x2d, 0x52, 0xbc, 0xd6, 0x6d, 0x33, 0xfc, 0x87, 0x94, 0x7e,
-	0x56, 0x4b, 0xdc, 0xf9, 0x5d, 0x2a, 0x41, 0x8a, 0x22, 0xec, 0x7d, 0x2f, 0xd9, 0xff, 0x11, 0x95,
-	0xe0, 0x84, 0xc6, 0x98, 0xc4, 0x7b, 0x94, 0x45, 0xaa, 0x77, 0xda, 0xe5, 0x7d, 0xca, 0xa2, 0x78,
-	0x89, 0x51, 0x3e, 0xa0, 0x2c, 0x86, 0xd0, 0x76, 0x2b, 0xe6, 0x07, 0x3d, 0xa6, 0x2c, 0x66, 0xb5,
-	0xc4, 0x9d, 0x3f, 0xa4, 0x2c, 0x26, 0x50, 0x92, 0xcc, 0xa0, 0x8f, 0x28, 0x8b, 0x13, 0x1a, 0x63,
-	0x12, 0xdf, 0xbe, 0xbd, 0xc5, 0x6e, 0x41, 0x25, 0xff, 0x5e, 0x89, 0xfd, 0x29, 0x5b, 0xbb, 0xd5,
-	0x40, 0xe7, 0xa8, 0x0f, 0xd8, 0xf9, 0xec, 0x21, 0xff, 0x47, 0xb6, 0xcf, 0x76, 0x9a, 0x9b, 0x28,
-	0xc7, 0x9e, 0x3a, 0x5d, 0xea, 0x76, 0x00, 0xe6, 0x3b, 0xad, 0xda, 0xde, 0xde, 0xa7, 0xa8, 0x70,
-	0xbb, 0x01, 0x78, 0xfc, 0xba, 0x4e, 0xe2, 0xea, 0xeb, 0xb9, 0xf4, 0xad, 0x58, 0x76, 0x3c, 0xfc,
-	0x13, 0xdd, 0x0a, 0x9e, 0xb8, 0x5e, 0x0c, 0xcd, 0xdd, 0xfe, 0xad, 0x39, 0x28, 0x27, 0x0a, 0xa1,
-	0xd4, 0x62, 0xf6, 0x9d, 0x63, 0xc7, 0x7d, 0x21, 0x0e, 0x84, 0x9f, 0xa3, 0x66, 0x15, 0xae, 0xa7,
-	0x13, 0x27, 0xcd, 0xf7, 0x88, 0xe7, 0xb3, 0x7b, 0x7b, 0x86, 0x38, 0x4e, 0x9e, 0xa3, 0xc6, 0x4a,
-	0xf3, 0x52, 0xe2, 0x04, 0x96, 0x11, 0x1f, 0x67, 0x47, 0x79, 0x7c, 0x11, 0x70, 0x8d, 0x5f, 0x65,
-	0xfb, 0x3c, 0x01, 0x2f, 0xd0, 0x6f, 0x85, 0xeb, 0x96, 0xcd, 0xa1, 0x7f, 0x8a, 0xe6, 0xa8, 0x0f,
-	0x10, 0x97, 0xcc, 0x5a, 0x6e, 0xc0, 0x4e, 0x3b, 0xa2, 0x79, 0xea, 0x88, 0xc2, 0x91, 0x6e, 0xf2,
-	0x23, 0x65, 0xdf, 0x1b, 0xba, 0x81, 0xde, 0x78, 0x69, 0x10, 0x62, 0x12, 0x5e, 0x7f, 0x42, 0x0b,
-	0xf8, 0x2d, 0xb8, 0x35, 0x15, 0xed, 0xa5, 0x41, 0xf8, 0x09, 0xfa, 0x22, 0x1d, 0x52, 0x78, 0x52,
-	0x9e, 0xf7, 0x2e, 0x51, 0xbd, 0xee, 0x3b, 0xe2, 0x5f, 0x2e, 0x88, 0x29, 0xce, 0x36, 0xf0, 0x46,
-	0xa0, 0xf8, 0x6c, 0x55, 0xd2, 0x72, 0x83, 0x27, 0xee, 0xd0, 0x31, 0x51, 0x99, 0x1a, 0x51, 0x32,
-	0x7c, 0x44, 0x2d, 0x8b, 0xec, 0x18, 0x7e, 0x78, 0x06, 0x2f, 0x84, 0x56, 0xe8, 0xc8, 0xba, 0xae,
-	0xbb, 0xab, 0x3b, 0xa7, 0x2a, 0x2f, 0x7b, 0xfb, 0xa8, 0x4a, 0x89, 0x30, 0xba, 0x5d, 0xe2, 0xf5,
-	0x2d, 0x47, 0x0f, 0xc2, 0xc1, 0x2c, 0x51, 0xd1, 0x44, 0x83, 0xa1, 0xa2, 0x61, 0x8e, 0xbb, 0xe9,
-	0xb0, 0xcb, 0x11, 0x9c, 0x15, 0xbd, 0x4f, 0xd0, 0x79, 0x2a, 0xda, 0x26, 0xbb, 0x2b, 0xa0, 0x07,
-	0xd6, 0x81, 0x2d, 0x72, 0x60, 0x84, 0xa9, 0x2e, 0x42, 0x26, 0x6a, 0xbe, 0x6f, 0xf5, 0xc4, 0x50,
-	0x96, 0xb1, 0x02, 0xab, 0x5d, 0x4f, 0x77, 0x7c, 0xbe, 0x67, 0x50, 0x77, 0x5d, 0xcf, 0xa4, 0x5f,
-	0x76, 0x63, 0x5e, 0x57, 0x92, 0x9f, 0x7a, 0xc9, 0x2e, 0x8e, 0x0f, 0x7d, 0x74, 0x81, 0x8e, 0xa0,
-	0xe5, 0x06, 0x35, 0xdb, 0x76, 0x5f, 0x84, 0x7c, 0x5e, 0xa4, 0xdf, 0x49, 0x91, 0x73, 0x0e, 0x6d,
-	0xcb, 0x08, 0xd0, 0xa5, 0x91, 0x86, 0x88, 0xb8, 0x2c, 0xfe, 0x8c, 0x8f, 0x8d, 0xec, 0x09, 0xb5,
-	0x1e, 0x13, 0x5d, 0xbe, 0xbd, 0x0d, 0x90, 0xb8, 0x3c, 0x4a, 0x7d, 0x5b, 0xfc, 0x97, 0x12, 0xfc,
-	0xbf, 0x0a, 0x97, 0x61, 0x29, 0x86, 0x7d, 0x6a, 0xe8, 0xcf, 0xef, 0x73, 0x33, 0x8c, 0x81, 0x35,
-	0x6a, 0x79, 0x3e, 0xca, 0xdd, 0xfe, 0x53, 0x09, 0x96, 0xf6, 0x46, 0xfe, 0x38, 0x64, 0x1e, 0x72,
-	0x27, 0xf7, 0xd0, 0x39, 0xf6, 0x4b, 0x7b, 0xd2, 0xdf, 0x0d, 0x94, 0x63, 0xbf, 0x0f, 0x50, 0x9e,
-	0xfd, 0x3e, 0x44, 0x05, 0xf6, 0xfb, 0x2e, 0x9a, 0x63, 0xbf, 0x8f, 0xd0, 0x3c, 0xfb, 0x7d, 0x0f,
-	0x2d, 0xb0, 0xdf, 0xf7, 0x51, 0x91, 0xfd, 0x7e, 0xc0, 0x23, 0xf5, 0xc9, 0xfd, 0x7b, 0x08, 0xf8,
-	0xc3, 0x7d, 0x54, 0xe6, 0x0f, 0x1b, 0x68, 0x91, 0x3f, 0x3c, 0x40, 0x15, 0xfe, 0xf0, 0x10, 0x55,
-	0xf9, 0xc3, 0xbb, 0x68, 0x89, 0x3f, 0x3c, 0x42, 0x88, 0x3f, 0xbc, 0x87, 0xce, 0xdf, 0x7e, 0x3b,
-	0xf9, 0x6f, 0x18, 0xe2, 0x00, 0x5a, 0x6d, 0xbf, 0xdb, 0xd6, 0x3a, 0x7b, 0x3b, 0xcd, 0xae, 0xb8,
-	0xca, 0xde, 0x6d, 0xd6, 0xb7, 0x3f, 0x45, 0xd2, 0x6d, 0x05, 0x4a, 0xd1, 0x6e, 0x0e, 0x6d, 0xa8,
-	0xb7, 0x77, 0x77, 0x19, 0x52, 0x09, 0xe6, 0x6a, 0x9b, 0x6d, 0xb5, 0x8b, 0xa4, 0xcd, 0x8d, 0x9f,
-	0xfc, 0x7c, 0x55, 0xfa, 0x87, 0x9f, 0xaf, 0x4a, 0xff, 0xf2, 0xf3, 0x55, 0x09, 0x14, 0xd7, 0xeb,
-	0xdd, 0xd5, 0x07, 0xba, 0x71, 0x44, 0xc2, 0x34, 0xc9, 0x70, 0xfb, 0x7d, 0xd7, 0xb9, 0xab, 0x87,
-	0xff, 0x66, 0xf9, 0x2c, 0xff, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x3f, 0x51, 0x56, 0xe1,
-	0x52, 0x00, 0x00,
+	// 6467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x4d, 0x70, 0x1b, 0x47,
+	0x76, 0xb0, 0x06, 0x00, 0x49, 0xe0, 0x81, 0x20, 0x47, 0x4d, 0x4a, 0x1a, 0xfd, 0x53, 0x23, 0xcb,
+	0xa6, 0x65, 0x5b, 0x2b, 0x51, 0xb2, 0x6c, 0xcb, 0xf6, 0xb7, 0x06, 0x41, 0x48, 0xc2, 0x8a, 0x04,
+	0xb8, 0x03, 0x50, 0xfe, 0xec, 0xef, 0xdb, 0x6f, 0x76, 0x88, 0x69, 0x82, 0x53, 0x1c, 0xcc, 0x60,
+	0x67, 0x06, 0x94, 0xe8, 0xaa, 0xef, 0x3b, 0x7c, 0x97, 0x9c, 0x92, 0x1c, 0x72, 0xc8, 0x2d, 0x5b,
+	0xc9, 0x25, 0xb9, 0x26, 0xb5, 0x87, 0x54, 0xa5, 0x2a, 0xc9, 0x21, 0xc9, 0xa6, 0x2a, 0x97, 0x24,
+	0x95, 0x1c, 0xf6, 0x96, 0xda, 0xca, 0xcf, 0x61, 0x2b, 0xa9, 0xdc, 0x72, 0x4c, 0xea, 0x75, 0xf7,
+	0xfc, 0x01, 0x03, 0x80, 0xb2, 0x37, 0x65, 0x97, 0x4f, 0x98, 0x79, 0xfd, 0xde, 0x9b, 0xd7, 0xef,
+	0xbd, 0x7e, 0xfd, 0xfa, 0x75, 0x37, 0x60, 0x79, 0x77, 0x68, 0xfb, 0x86, 0x57, 0x1d, 0x58, 0x77,
+	0x06, 0x9e, 0x1b, 0xb8, 0x64, 0x71, 0xc0, 0x00, 0xfc, 0x4d, 0xfd, 0x49, 0x1e, 0xe6, 0xdb, 0xdd,
+	0x43, 0xda, 0x37, 0x08, 0x81, 0x82, 0x63, 0xf4, 0xa9, 0x22, 0xad, 0xe5, 0xd6, 0x4b, 0x1a, 0x7b,
+	0x26, 0xd7, 0xa1, 0xec, 0xb3, 0x56, 0xdd, 0x34, 0x02, 0x43, 0xc9, 0xaf, 0xe5, 0xd6, 0x17, 0x35,
+	0xe0, 0xa0, 0x2d, 0x23, 0x30, 0xc8, 0x3b, 0x50, 0x08, 0x4e, 0x06, 0x54, 0x29, 0xac, 0xe5, 0xd6,
+	0x97, 0x36, 0x2e, 0xde, 0x49, 0x32, 0xbf, 0xc3, 0x19, 0xdf, 0xe9, 0x9c, 0x0c, 0xa8, 0xc6, 0xd0,
+	0xc8, 0x43, 0x80, 0x81, 0xe7, 0x0e, 0xa8, 0x17, 0x58, 0xd4, 0x57, 0xe6, 0xd6, 0xf2, 0xeb, 0xe5,
+	0x8d, 0xf3, 0x69, 0xa2, 0x67, 0xf4, 0xe4, 0xb9, 0x61, 0x0f, 0xa9, 0x96, 0xc0, 0x54, 0x7f, 0x35,
+	0x07, 0x05, 0x64, 0x43, 0x8a, 0x50, 0x68, 0xba, 0x0e, 0x95, 0xcf, 0x10, 0x80, 0xf9, 0x76, 0xe0,
+	0x59, 0x4e, 0x4f, 0x96, 0x10, 0xfa, 0x3d, 0xdf, 0x75, 0xe4, 0x1c, 0x59, 0x84, 0xe2, 0x2e, 0xb2,
+	0xd9, 0x1f, 0x1e, 0xc8, 0x79, 0x84, 0x57, 0x8f, 0x3d, 0x57, 0x2e, 0xe0, 0xd3, 0xa6, 0xeb, 0xda,
+	0xf2, 0x1c, 0x3e, 0x35, 0x9c, 0xe0, 0x7d, 0x79, 0x9e, 0x94, 0x60, 0xae, 0xe1, 0x04, 0xf7, 0x1e,
+	0xca, 0x0b, 0xe2, 0xf1, 0xfe, 0x86, 0x5c, 0x14, 0x8f, 0x0f, 0x1f, 0xc8, 0x25, 0x7c, 0x7c, 0x6c,
+	0xbb, 0x46, 0x20, 0x03, 0x7e, 0x6d, 0xcb, 0x1d, 0xee, 0xdb, 0x54, 0x2e, 0x23, 0x87, 0x2d, 0x23,
+	0xa0, 0xf2, 0x22, 0x3e, 0x75, 0xac, 0x3e, 0x95, 0x2b, 0xa4, 0x02, 0x25, 0x7c, 0xf2, 0x03, 0xa3,
+	0x3f, 0x90, 0x97, 0x50, 0x8c, 0xb0, 0x1f, 0xf2, 0x32, 0x29, 0xc3, 0x42, 0xc3, 0xf1, 0x03, 0xc3,
+	0x09, 0x64, 0x19, 0x31, 0xb7, 0xdd, 0xae, 0x61, 0x33, 0x16, 0x67, 0xa3, 0x57, 0xc6, 0x87, 0x90,
+	0xb3, 0x50, 0x89, 0x5a, 0x19, 0x68, 0x85, 0x10, 0x58, 0x0a, 0xbb, 0xd4, 0x34, 0x02, 0xeb, 0x98,
+	0xca, 0xab, 0xea, 0x9f, 0x49, 0x50, 0xd9, 0xa1, 0xbe, 0x6f, 0xf4, 0x68, 0xc3, 0x64, 0x86, 0xb8,
+	0x04, 0x45, 0x9b, 0x9a, 0x3d, 0xea, 0x35, 0x4c, 0x66, 0xc1, 0x82, 0x16, 0xbd, 0x13, 0x05, 0x16,
+	0xa8, 0x13, 0x78, 0x27, 0x0d, 0x53, 0xc9, 0xb1, 0xa6, 0xf0, 0x95, 0xac, 0x41, 0x69, 0x60, 0x78,
+	0x81, 0x15, 0x58, 0xae, 0xa3, 0xe4, 0xd7, 0xa4, 0xf5, 0xb9, 0x47, 0xb9, 0x77, 0xee, 0x69, 0x31,
+	0x90, 0xdc, 0x84, 0xf2, 0xbe, 0x11, 0x74, 0x0f, 0x75, 0xcb, 0x31, 0xe9, 0x4b, 0xa5, 0x10, 0xe1,
+	0x00, 0x03, 0x37, 0x10, 0x4a, 0x2e, 0xc0, 0x82, 0xd1, 0x3d, 0xd2, 0x7d, 0x1a, 0x30, 0x9b, 0xe6,
+	0xb5, 0x79, 0xa3, 0x7b, 0xd4, 0xa6, 0x01, 0xb9, 0x0a, 0x1c, 0x4d, 0xf7, 0xad, 0x2f, 0xa8, 0x32,
+	0x8f, 0xc4, 0x5a, 0x89, 0x41, 0xda, 0xd6, 0x17, 0x54, 0xdd, 0x88, 0xd5, 0x44, 0x64, 0xc8, 0x1f,
+	0xd1, 0x13, 0xe1, 0x7d, 0xf8, 0x48, 0x56, 0x61, 0xee, 0x18, 0x9b, 0x98, 0xd0, 0x25, 0x8d, 0xbf,
+	0xa8, 0x0f, 0x61, 0xf1, 0x19, 0x3d, 0xd9, 0x76, 0x9d, 0xde, 0xa9, 0xe8, 0x0a, 0x21, 0xdd, 0x06,
+	0x14, 0x1b, 0x4e, 0xa0, 0x19, 0x4e, 0x8f, 0x22, 0x86, 0x1f, 0x18, 0x5e, 0xc0, 0xa8, 0xe6, 0x34,
+	0xfe, 0x82, 0x9c, 0xa8, 0xc3, 0x55, 0x34, 0xa7, 0xe1, 0xa3, 0x6a, 0xc3, 0x52, 0xdd, 0xe9, 0x7a,
+	0x27, 0x03, 0x54, 0xc5, 0x33, 0x7a, 0xe2, 0xcf, 0xfa, 0xda, 0xa2, 0xf8, 0x1a, 0xd9, 0x80, 0x62,
+	0x9f, 0x06, 0x86, 0x18, 0x35, 0xd3, 0xdc, 0x3c, 0xc2, 0x53, 0xff, 0xa6, 0x04, 0xcb, 0xc2, 0xa8,
+	0x3b, 0x02, 0x46, 0x6e, 0x42, 0x65, 0xe0, 0xb9, 0xe6, 0xb0, 0x4b, 0x3d, 0x3d, 0x31, 0x3a, 0x17,
+	0x43, 0x60, 0x33, 0x1c, 0xa5, 0xf4, 0x47, 0x43, 0xea, 0x74, 0xa9, 0x6e, 0x85, 0x36, 0x86, 0x10,
+	0xd4, 0x30, 0xc9, 0x0d, 0x58, 0x1c, 0x0c, 0xf7, 0x6d, 0xcb, 0x3f, 0xd4, 0x03, 0xab, 0x4f, 0xd9,
+	0x38, 0x2e, 0x68, 0x65, 0x01, 0x43, 0x3f, 0x1b, 0x19, 0x99, 0x85, 0xd3, 0x8e, 0x4c, 0xf2, 0x06,
+	0x2c, 0x7b, 0x74, 0x60, 0x5b, 0x5d, 0x23, 0xa0, 0xa6, 0x7e, 0xe0, 0xb9, 0x7d, 0x65, 0x6e, 0x4d,
+	0x5a, 0x2f, 0x69, 0x4b, 0x31, 0xf8, 0xb1, 0xe7, 0xf6, 0x59, 0x4f, 0x42, 0xaf, 0xd2, 0x51, 0x87,
+	0xf3, 0x0c, 0x6d, 0x31, 0x02, 0x3e, 0xa3, 0x27, 0x28, 0x68, 0x44, 0xa6, 0x07, 0xae, 0xb2, 0xb0,
+	0x96, 0x5f, 0x2f, 0x69, 0xe5, 0x08, 0xd6, 0x71, 0x49, 0x1d, 0xca, 0x5d, 0xb7, 0x3f, 0xf0, 0xa8,
+	0xef, 0xa3, 0xd3, 0x16, 0xd7, 0xa4, 0xf5, 0xa5, 0x8d, 0xab, 0x69, 0x49, 0x6b, 0x31, 0x02, 0x46,
+	0x8d, 0x47, 0x85, 0x66, 0xab, 0x59, 0xd7, 0x92, 0x74, 0xe4, 0x0e, 0x9c, 0x1d, 0x3a, 0x21, 0x80,
+	0x9a, 0xdc, 0x41, 0x4b, 0x6b, 0xd2, 0x7a, 0xe5, 0x91, 0x74, 0x57, 0x93, 0x93, 0x6d, 0xe8, 0xaa,
+	0xe4, 0x01, 0x9c, 0x73, 0x86, 0x7d, 0xbd, 0xcf, 0xed, 0xe3, 0xeb, 0x96, 0xa3, 0x33, 0x3f, 0x56,
+	0xca, 0x6c, 0x44, 0x48, 0xf7, 0x34, 0xe2, 0x0c, 0xfb, 0xc2, 0x7c, 0x7e, 0xc3, 0xd9, 0xc4, 0x46,
+	0xb2, 0x06, 0x40, 0x8f, 0xa9, 0x13, 0x70, 0xb5, 0x2f, 0xae, 0x49, 0xeb, 0x05, 0x64, 0x5f, 0x62,
+	0x40, 0xa6, 0xf7, 0x3a, 0x2c, 0xd3, 0xc8, 0xc5, 0x50, 0x2f, 0xbe, 0x52, 0x61, 0xca, 0xbf, 0x92,
+	0xee, 0x52, 0xda, 0x0f, 0xb5, 0x25, 0x9a, 0x7a, 0x47, 0x33, 0x24, 0xd8, 0x18, 0x76, 0xcf, 0x55,
+	0x96, 0xb8, 0x19, 0x62, 0x70, 0xd5, 0xee, 0xb9, 0xe4, 0x4d, 0x90, 0x13, 0x88, 0x03, 0xc3, 0x33,
+	0xfa, 0xca, 0xf2, 0x9a, 0xb4, 0xbe, 0xa8, 0x25, 0x18, 0xec, 0x22, 0x98, 0xdc, 0x82, 0x25, 0x11,
+	0xfc, 0x8f, 0xa9, 0xc7, 0x94, 0x2d, 0x33, 0xc4, 0x0a, 0x87, 0x3e, 0xe7, 0x40, 0xf2, 0x09, 0x5c,
+	0x4c, 0x19, 0x56, 0xdf, 0x7f, 0xf8, 0x40, 0xa7, 0x4e, 0xd7, 0x35, 0xa9, 0xa9, 0x9c, 0x5d, 0x93,
+	0xd6, 0x8b, 0x8f, 0xe6, 0x0e, 0x0c, 0xdb, 0xa7, 0xda, 0xf9, 0xa4, 0xad, 0x37, 0x1f, 0x3e, 0xa8,
+	0x73, 0x24, 0xb4, 0xba, 0xeb, 0x99, 0x14, 0x83, 0x39, 0xf3, 0x0c, 0xc2, 0x3e, 0x53, 0x0e, 0x61,
+	0xe8, 0x18, 0xaf, 0xc3, 0xb2, 0x49, 0x6d, 0xeb, 0x98, 0x7a, 0xba, 0x21, 0xb4, 0xb9, 0xb2, 0x26,
+	0xad, 0xe7, 0xb5, 0x8a, 0x00, 0x57, 0xb9, 0x3a, 0xaf, 0x43, 0xb9, 0x6f, 0x78, 0x47, 0xd4, 0xd3,
+	0xd9, 0xb4, 0xb4, 0xca, 0x22, 0x0e, 0x70, 0x10, 0x9b, 0x40, 0xd6, 0x41, 0x0e, 0x5e, 0x3a, 0x96,
+	0xa9, 0xdb, 0xd4, 0xf0, 0x03, 0x7d, 0xdf, 0x0a, 0x7c, 0xe5, 0x3c, 0xda, 0x45, 0x5b, 0x62, 0xf0,
+	0x6d, 0x04, 0x6f, 0x5a, 0x81, 0x8f, 0x9f, 0xe4, 0x98, 0x7d, 0x37, 0x44, 0xbc, 0xc0, 0x10, 0x2b,
+	0x0c, 0xbc, 0xe3, 0x0a, 0xbc, 0x7b, 0xb0, 0x72, 0x68, 0xf5, 0x0e, 0xa9, 0x1f, 0xe8, 0xc9, 0x51,
+	0xa8, 0x84, 0xc6, 0x3e, 0x2b, 0x5a, 0xdb, 0xf1, 0x78, 0x7c, 0x0d, 0xc0, 0x19, 0xda, 0xb6, 0xce,
+	0x03, 0xc7, 0xc5, 0xa4, 0x8e, 0x4a, 0xd8, 0xc0, 0x23, 0x1b, 0x81, 0xc2, 0x70, 0x68, 0x99, 0xca,
+	0x25, 0x66, 0x48, 0xf6, 0x4c, 0xde, 0x81, 0x15, 0x74, 0xc3, 0xee, 0xe1, 0xd0, 0x39, 0xf2, 0xd9,
+	0x70, 0xd3, 0xfb, 0x7e, 0x4f, 0xb9, 0xcc, 0xfa, 0x29, 0x3b, 0xc3, 0x7e, 0x8d, 0xb5, 0xe0, 0x88,
+	0xdb, 0xf1, 0x7b, 0xe4, 0x3b, 0xb0, 0x1a, 0xb8, 0x81, 0x61, 0x73, 0x02, 0x44, 0xe5, 0x8e, 0x7e,
+	0x85, 0xe1, 0x9f, 0x65, 0x6d, 0x8c, 0x62, 0xc7, 0xef, 0x31, 0x37, 0xbf, 0x08, 0x45, 0x8e, 0x6a,
+	0x99, 0xca, 0x55, 0x86, 0xb4, 0xc0, 0xde, 0x1b, 0x26, 0xb9, 0x0f, 0x84, 0x09, 0x9d, 0x1e, 0xc5,
+	0xd7, 0x92, 0xc2, 0xcb, 0x88, 0xb0, 0x9b, 0x30, 0xb2, 0xfa, 0x17, 0x79, 0x38, 0xd7, 0xb6, 0x9c,
+	0x9e, 0x4d, 0x47, 0x23, 0x5b, 0x3a, 0xe0, 0x48, 0xa7, 0x0e, 0x38, 0x63, 0x71, 0x24, 0x97, 0x1d,
+	0x47, 0x06, 0xc6, 0x89, 0xed, 0x1a, 0x62, 0x60, 0xe7, 0x59, 0x4c, 0x2f, 0x0b, 0x18, 0xeb, 0xe9,
+	0x6d, 0xa8, 0xe0, 0x10, 0x37, 0xba, 0x18, 0xb7, 0xdc, 0x61, 0xa0, 0x14, 0x92, 0x3d, 0x59, 0x8c,
+	0xda, 0x5a, 0xc3, 0x60, 0x64, 0x18, 0xcf, 0x65, 0x0c, 0xe3, 0xa9, 0x83, 0x60, 0xfe, 0xcb, 0x0c,
+	0x82, 0x85, 0xf1, 0x41, 0x30, 0x12, 0xe7, 0x8b, 0x6b, 0xd2, 0x48, 0x9c, 0x4f, 0xfb, 0x55, 0x69,
+	0x82, 0x5f, 0x65, 0x1b, 0x12, 0xa6, 0x1b, 0xf2, 0x39, 0xac, 0x6c, 0x7a, 0xee, 0x11, 0xf5, 0xea,
+	0x98, 0x3a, 0x44, 0x56, 0x7c, 0x13, 0xe4, 0x7d, 0x06, 0xd6, 0x83, 0x30, 0xfd, 0x51, 0x24, 0x26,
+	0xd7, 0x32, 0x87, 0x47, 0x59, 0x11, 0x4e, 0x94, 0x3c, 0x87, 0xc8, 0xb1, 0x76, 0xfe, 0xa2, 0xfe,
+	0x73, 0x1e, 0x96, 0x6a, 0x6e, 0xbf, 0x6f, 0x38, 0x66, 0xcd, 0x75, 0x1c, 0xda, 0x0d, 0x30, 0xee,
+	0x74, 0x6d, 0x0b, 0xd5, 0x1d, 0xc6, 0x1d, 0x3e, 0xe9, 0x55, 0x38, 0x34, 0x8c, 0x3b, 0x1f, 0x40,
+	0xd9, 0x18, 0x06, 0x87, 0x7a, 0x9f, 0x06, 0x87, 0xae, 0xc9, 0xb8, 0x2e, 0x6d, 0x28, 0x69, 0x0f,
+	0xaa, 0x0e, 0x83, 0xc3, 0x1d, 0xd6, 0xae, 0x81, 0x11, 0x3d, 0x63, 0x10, 0x48, 0x90, 0xf2, 0x89,
+	0x55, 0xcc, 0x5a, 0x31, 0x16, 0x9b, 0x5a, 0x2f, 0x43, 0x89, 0x61, 0x8a, 0x89, 0x1c, 0x4d, 0x52,
+	0x44, 0x00, 0xcb, 0xb9, 0xde, 0x06, 0x99, 0x7d, 0xa6, 0xeb, 0xda, 0x91, 0xa8, 0x3c, 0x41, 0x92,
+	0xee, 0x6a, 0xcb, 0x61, 0x53, 0x28, 0xef, 0x3b, 0xb0, 0x32, 0xf0, 0xdc, 0x97, 0x27, 0x7a, 0xe0,
+	0xea, 0x42, 0x67, 0x43, 0xcf, 0x16, 0xd3, 0xa0, 0xcc, 0x9a, 0x3a, 0x2e, 0xd7, 0xf1, 0x9e, 0x67,
+	0x93, 0x77, 0x80, 0xb8, 0x9e, 0xd5, 0xb3, 0x1c, 0xc3, 0xd6, 0x07, 0x9e, 0xe5, 0x74, 0xad, 0x81,
+	0x61, 0x33, 0xaf, 0x28, 0x69, 0x67, 0xc3, 0x96, 0xdd, 0xb0, 0x81, 0xbc, 0x9d, 0x40, 0x8f, 0x25,
+	0x2e, 0x72, 0xe6, 0x61, 0x4b, 0x35, 0x94, 0xfc, 0x2e, 0xac, 0xa6, 0xb1, 0x85, 0x12, 0x4b, 0x0c,
+	0x9f, 0x24, 0xf1, 0x85, 0xca, 0xbe, 0x0b, 0x95, 0x03, 0x6a, 0x04, 0x43, 0x8f, 0xea, 0x07, 0xb6,
+	0xd1, 0xf3, 0x99, 0xbf, 0x94, 0x37, 0x2e, 0xa5, 0xf5, 0xfd, 0x98, 0xa3, 0x3c, 0x46, 0x0c, 0x6d,
+	0xf1, 0x20, 0xf1, 0xa6, 0xfe, 0x66, 0x0e, 0x16, 0x93, 0xcd, 0xe4, 0x03, 0x38, 0xe7, 0x0f, 0x07,
+	0x03, 0xd7, 0x0b, 0x7c, 0x2e, 0x83, 0x47, 0x0f, 0x3c, 0xea, 0x1f, 0x2a, 0x52, 0xd2, 0x13, 0x57,
+	0x42, 0x1c, 0x94, 0x45, 0xe3, 0x18, 0xe4, 0x7b, 0x70, 0x2d, 0x22, 0x15, 0xaa, 0x64, 0x19, 0xad,
+	0x1e, 0xe5, 0x5c, 0xb9, 0x24, 0x8f, 0xcb, 0x21, 0x72, 0x96, 0x07, 0x57, 0xe1, 0x62, 0xc4, 0x8b,
+	0x8d, 0x08, 0xa6, 0x6f, 0x9e, 0x5d, 0x29, 0xf9, 0x24, 0x9b, 0x0b, 0x21, 0xde, 0x2e, 0x47, 0xdb,
+	0x15, 0x58, 0xe4, 0x63, 0x88, 0x9a, 0xf4, 0xc0, 0x1d, 0x58, 0x5d, 0xfd, 0x05, 0xce, 0xfe, 0xd4,
+	0xf3, 0xd3, 0x41, 0x25, 0xea, 0x6f, 0x07, 0x91, 0x3e, 0x15, 0x38, 0xea, 0xdf, 0x4a, 0x20, 0xa7,
+	0x87, 0x00, 0x35, 0xd9, 0xe4, 0x4b, 0x3d, 0x9c, 0xef, 0x46, 0x06, 0x01, 0x87, 0x86, 0x4e, 0x95,
+	0xe5, 0x82, 0xb9, 0x89, 0x2e, 0xb8, 0x0e, 0x72, 0xdf, 0x78, 0x19, 0x26, 0x31, 0x61, 0x68, 0xc4,
+	0x28, 0xbf, 0xd4, 0x37, 0x5e, 0x8a, 0x08, 0xcd, 0xa2, 0xe3, 0x98, 0xb9, 0x0b, 0xaf, 0x68, 0xee,
+	0xdf, 0x92, 0x60, 0x45, 0x74, 0x8a, 0x5b, 0xce, 0x1f, 0xb8, 0x8e, 0x4f, 0x33, 0x07, 0xb7, 0x34,
+	0x3e, 0xb8, 0x37, 0xa0, 0xe8, 0x09, 0x12, 0xd6, 0x9f, 0xb1, 0xb9, 0x21, 0x74, 0x65, 0x2d, 0xc2,
+	0xcb, 0xd4, 0x45, 0x7e, 0x92, 0x2e, 0xd4, 0xdf, 0x96, 0x60, 0x35, 0x21, 0x60, 0xed, 0xd0, 0xb0,
+	0x6d, 0x8a, 0x8b, 0x83, 0x2c, 0xcd, 0x4b, 0xe3, 0x9a, 0x7f, 0x00, 0xa5, 0x6e, 0x48, 0x33, 0x43,
+	0xc4, 0x18, 0xf1, 0x15, 0x65, 0xfc, 0x3e, 0x14, 0xa3, 0x21, 0x9b, 0x15, 0xb3, 0xa4, 0xd9, 0x31,
+	0x2b, 0x97, 0x8e, 0x59, 0xea, 0x5f, 0x49, 0x50, 0x79, 0x46, 0x4f, 0xda, 0x87, 0x86, 0x47, 0x4d,
+	0x1c, 0x04, 0xa4, 0x0a, 0x95, 0xa3, 0x08, 0xe0, 0x9a, 0x7c, 0x89, 0xb1, 0xb4, 0x71, 0x79, 0x6c,
+	0x2e, 0x8e, 0x51, 0xb4, 0x34, 0x05, 0xce, 0xe5, 0x87, 0x86, 0x7f, 0xc8, 0x16, 0x57, 0x7e, 0xf6,
+	0x7a, 0x27, 0x5c, 0x7b, 0x69, 0x09, 0x4c, 0xf2, 0x5d, 0xb8, 0x60, 0xd8, 0xb6, 0xfb, 0xa2, 0x35,
+	0x0c, 0x5a, 0x07, 0x2d, 0x9c, 0xe9, 0xb6, 0x78, 0x36, 0x77, 0x92, 0x1e, 0x38, 0x93, 0xb0, 0xd4,
+	0x5f, 0x2c, 0x44, 0x43, 0xa7, 0x3d, 0xdc, 0xf7, 0xbb, 0x9e, 0xb5, 0xcf, 0x56, 0x77, 0x6c, 0x14,
+	0x8a, 0x11, 0xc3, 0x5f, 0x88, 0x0a, 0x8b, 0x3e, 0x47, 0x61, 0x29, 0xae, 0x58, 0x54, 0xa6, 0x60,
+	0xe4, 0x13, 0x58, 0xf0, 0x87, 0xfb, 0x98, 0x27, 0xb2, 0x8c, 0x61, 0x69, 0xe3, 0xf5, 0xb1, 0x75,
+	0x45, 0xea, 0x53, 0x77, 0xda, 0x1c, 0x5b, 0x0b, 0xc9, 0x70, 0x8a, 0xee, 0xba, 0x8e, 0x3f, 0xec,
+	0x53, 0x0f, 0xa7, 0xe8, 0x02, 0x5f, 0x8a, 0x85, 0xa0, 0x86, 0x89, 0x2b, 0x62, 0x0f, 0x27, 0x6c,
+	0x3f, 0xc0, 0xf6, 0x39, 0xd6, 0x5e, 0x12, 0x90, 0x86, 0x89, 0xd9, 0x4d, 0x44, 0xcf, 0x4c, 0x2c,
+	0x56, 0x49, 0x21, 0x90, 0x19, 0xf8, 0x16, 0x2c, 0x0d, 0x3c, 0xcb, 0xf5, 0xac, 0xe0, 0x44, 0xb7,
+	0xe9, 0x31, 0xe5, 0xd3, 0xc2, 0x9c, 0x56, 0x09, 0xa1, 0xdb, 0x08, 0x24, 0xd7, 0x60, 0xc1, 0x1c,
+	0x7a, 0xc6, 0xbe, 0x4d, 0xd9, 0x3c, 0x50, 0x7c, 0x54, 0x08, 0xbc, 0x21, 0xd5, 0x42, 0x20, 0xa9,
+	0x83, 0xcc, 0x16, 0xbe, 0x51, 0x3c, 0xb0, 0xf8, 0x04, 0x50, 0x1e, 0xb5, 0x7d, 0xaa, 0xd2, 0xa0,
+	0x2d, 0x31, 0xa2, 0x08, 0x96, 0x5a, 0xea, 0xc2, 0xe9, 0x96, 0xba, 0xd8, 0x03, 0x8f, 0x1a, 0xa6,
+	0x1e, 0x65, 0x59, 0x6c, 0x19, 0x55, 0xd4, 0x2a, 0x08, 0xad, 0x85, 0x40, 0xf2, 0x36, 0xcc, 0xf3,
+	0xb5, 0x06, 0x5b, 0x3a, 0x95, 0x37, 0x56, 0xb3, 0xea, 0x4b, 0x9a, 0xc0, 0x21, 0x3f, 0x84, 0x65,
+	0xcb, 0xb1, 0x58, 0x64, 0x76, 0x7d, 0x5e, 0xd2, 0xa8, 0xb0, 0xa4, 0xe0, 0xce, 0x0c, 0x2b, 0x36,
+	0xd2, 0x54, 0x8f, 0xe6, 0xb7, 0x8d, 0x80, 0xfa, 0x81, 0x36, 0xca, 0x8e, 0x7c, 0x02, 0x57, 0xe2,
+	0xe5, 0x69, 0xd2, 0x73, 0x74, 0x3f, 0x30, 0x02, 0xca, 0x96, 0x5c, 0x45, 0xed, 0x52, 0x84, 0xd3,
+	0x4e, 0xa0, 0xb4, 0x11, 0x83, 0x3c, 0x84, 0xd5, 0x03, 0xd7, 0xeb, 0x52, 0x31, 0x4f, 0x74, 0x3d,
+	0x6a, 0x30, 0x41, 0x97, 0x13, 0x06, 0x22, 0x0c, 0x83, 0xcd, 0x11, 0x35, 0xd1, 0x4e, 0x5a, 0x70,
+	0x33, 0x6d, 0x2b, 0xcf, 0xb5, 0xed, 0x7d, 0x2c, 0xba, 0xa0, 0x35, 0xb9, 0x08, 0xb4, 0xab, 0xc8,
+	0x61, 0x6a, 0x7a, 0x3d, 0x69, 0x24, 0x4d, 0xe0, 0x6e, 0x09, 0xd4, 0x36, 0xed, 0xa6, 0x47, 0x3d,
+	0x0d, 0x0c, 0xe5, 0x6c, 0x96, 0xe5, 0x53, 0x91, 0x42, 0x4b, 0x53, 0xa8, 0x9b, 0xb0, 0x20, 0xfc,
+	0x1f, 0xab, 0x58, 0xf5, 0x97, 0x5d, 0x7b, 0xe8, 0x5b, 0xc7, 0x61, 0x6d, 0x8e, 0xe1, 0xc9, 0x12,
+	0x96, 0xc2, 0x1e, 0x1b, 0x96, 0xed, 0x1e, 0x53, 0x4f, 0xce, 0x91, 0x25, 0x80, 0x67, 0xf4, 0x44,
+	0x17, 0xad, 0x79, 0xf5, 0x2d, 0x58, 0x1e, 0xd1, 0x3e, 0x12, 0x73, 0xfd, 0xcb, 0x67, 0x90, 0xb8,
+	0x6e, 0x78, 0xb6, 0x85, 0x6f, 0x92, 0xfa, 0x4f, 0x12, 0x5c, 0x17, 0xc6, 0x8b, 0x72, 0x53, 0x6a,
+	0x32, 0x45, 0x45, 0xd3, 0x79, 0xf6, 0xe0, 0x4f, 0x8f, 0xba, 0xdc, 0xe8, 0xa8, 0xcb, 0xce, 0xb5,
+	0xf2, 0xaf, 0x96, 0x6b, 0x15, 0x5e, 0x31, 0xd7, 0x9a, 0x9b, 0x94, 0x6b, 0xa9, 0x7f, 0x98, 0x83,
+	0x37, 0x66, 0xf4, 0x33, 0x9a, 0x4f, 0xaf, 0x01, 0x44, 0x79, 0xbc, 0xcf, 0x26, 0x84, 0x8a, 0x96,
+	0x80, 0xcc, 0xea, 0xf9, 0xff, 0x4e, 0xcc, 0xb3, 0x79, 0x36, 0x58, 0x3e, 0xc9, 0x1c, 0x2c, 0xb3,
+	0xe4, 0xb8, 0xb3, 0xed, 0xba, 0x47, 0xc3, 0x01, 0x0b, 0x86, 0xf1, 0x8c, 0xfc, 0x1d, 0x98, 0xa3,
+	0x9e, 0xe7, 0x7a, 0x4c, 0x37, 0xe3, 0xe5, 0x61, 0x36, 0x9f, 0xd6, 0x11, 0x41, 0xe3, 0x78, 0x58,
+	0xa9, 0x14, 0x0e, 0x2e, 0xd4, 0x13, 0xbe, 0xaa, 0xb7, 0x00, 0xe2, 0x4f, 0x60, 0x45, 0xb5, 0x3d,
+	0xec, 0x76, 0xa9, 0xef, 0x73, 0x6f, 0x43, 0x0f, 0x43, 0x6f, 0x53, 0xff, 0x24, 0x07, 0x44, 0x88,
+	0x2c, 0xd0, 0x99, 0xfd, 0xbf, 0x94, 0x57, 0xbc, 0x05, 0x15, 0xb4, 0x17, 0x46, 0x54, 0x56, 0x77,
+	0x4d, 0x67, 0x83, 0xe9, 0xb6, 0x09, 0x2e, 0x54, 0x78, 0x35, 0x17, 0x9a, 0x7b, 0x45, 0x17, 0x9a,
+	0x9f, 0x98, 0xae, 0xbf, 0x0f, 0x8a, 0x61, 0x1e, 0x53, 0x2f, 0xb0, 0xb0, 0xb8, 0x65, 0x5b, 0x7e,
+	0x40, 0x9d, 0x70, 0x4a, 0xe1, 0x6b, 0x88, 0xf3, 0x71, 0xfb, 0xb6, 0x68, 0xc6, 0xc9, 0x45, 0xfd,
+	0x59, 0x1e, 0x2e, 0x8d, 0x6b, 0x30, 0xf2, 0xb7, 0xdb, 0xe1, 0x82, 0x0f, 0xad, 0x67, 0x75, 0xe9,
+	0x9e, 0x67, 0x8b, 0x34, 0x64, 0x0c, 0x4e, 0xee, 0xc2, 0xca, 0x28, 0xac, 0x63, 0xfb, 0x62, 0xc1,
+	0x9e, 0xd5, 0x44, 0x5a, 0x63, 0xee, 0x78, 0x3f, 0xd3, 0x1d, 0x33, 0x24, 0xcb, 0xf6, 0xc0, 0xb4,
+	0x89, 0x0b, 0x33, 0x4d, 0x3c, 0x37, 0xc5, 0xc4, 0x91, 0x37, 0xcf, 0xbf, 0xba, 0x37, 0x2f, 0xa4,
+	0xbc, 0x99, 0x95, 0x0b, 0xf8, 0x5a, 0xf0, 0xd0, 0x73, 0x87, 0xbd, 0x43, 0xdd, 0xe7, 0x6a, 0x60,
+	0x2b, 0xc2, 0x62, 0xba, 0x5c, 0xc0, 0x16, 0x86, 0x1c, 0x2d, 0x56, 0x96, 0x7a, 0x3f, 0x35, 0x1e,
+	0x16, 0xa1, 0xa8, 0x51, 0xd3, 0xf2, 0x68, 0x17, 0xa3, 0x66, 0x19, 0x16, 0xc4, 0x42, 0x42, 0x96,
+	0x12, 0xa3, 0x23, 0xa7, 0xfe, 0x5b, 0x1e, 0x96, 0xc3, 0x01, 0x1d, 0x2e, 0x5e, 0xb2, 0x87, 0xc6,
+	0x75, 0x28, 0x47, 0x75, 0xe7, 0xb8, 0xa4, 0x1c, 0x82, 0xc6, 0xf2, 0x98, 0x7c, 0x46, 0x1e, 0x93,
+	0xae, 0x5b, 0x17, 0x44, 0x95, 0x26, 0x59, 0xb7, 0xbe, 0x09, 0x25, 0x51, 0x73, 0xa4, 0x66, 0x5a,
+	0xf3, 0x31, 0x3c, 0x95, 0x5e, 0xcc, 0x9f, 0x32, 0xbd, 0x88, 0xf3, 0x86, 0x85, 0x53, 0xe4, 0x0d,
+	0x17, 0x60, 0x8e, 0x0e, 0xdc, 0xee, 0xa1, 0x52, 0x0c, 0x67, 0x4f, 0xfe, 0x4e, 0x6a, 0x70, 0x79,
+	0xe8, 0x53, 0x0f, 0x97, 0x83, 0xc7, 0x96, 0x49, 0x4d, 0x3d, 0xdd, 0xa5, 0x52, 0x62, 0xce, 0x56,
+	0x10, 0x71, 0x57, 0xe0, 0xed, 0x26, 0x3b, 0xf9, 0x39, 0xac, 0x46, 0x64, 0x06, 0x0b, 0x59, 0x7a,
+	0x1f, 0xb3, 0x6c, 0x60, 0x4e, 0xb4, 0x96, 0x96, 0x2c, 0xa4, 0xac, 0x32, 0x44, 0xcc, 0xad, 0x1f,
+	0x89, 0x99, 0x54, 0x23, 0x83, 0xb1, 0x36, 0xb4, 0x12, 0xcf, 0x23, 0xb8, 0xfc, 0x65, 0x5e, 0x10,
+	0x62, 0xa0, 0x3a, 0x42, 0xd4, 0xdf, 0xc9, 0x41, 0x39, 0x4c, 0x77, 0xa8, 0x63, 0x8e, 0x9a, 0x55,
+	0x1a, 0x33, 0xeb, 0xcc, 0xad, 0x84, 0xd7, 0x60, 0x31, 0x59, 0x07, 0x0f, 0x17, 0x2f, 0xf7, 0xb4,
+	0x72, 0xa2, 0xfc, 0x4d, 0xde, 0xca, 0xa8, 0xb2, 0x16, 0x42, 0xed, 0x8e, 0x16, 0x5a, 0xdf, 0x1c,
+	0x2f, 0xb4, 0x46, 0x25, 0xb6, 0xd3, 0xd5, 0x5a, 0xe7, 0xa7, 0xd4, 0x5a, 0xd7, 0xa0, 0x68, 0xf9,
+	0xbc, 0xfe, 0xa9, 0x2c, 0x24, 0x7d, 0x6c, 0xc1, 0xf2, 0x59, 0xe9, 0x53, 0xfd, 0x73, 0x09, 0x48,
+	0x42, 0x49, 0x1a, 0xed, 0x52, 0x6b, 0x10, 0xfc, 0x12, 0x74, 0xf5, 0x08, 0x20, 0x91, 0x5a, 0xe7,
+	0x67, 0xa7, 0xd6, 0xa5, 0x7e, 0xf8, 0x3a, 0xa9, 0xa7, 0x85, 0xc9, 0x3d, 0x55, 0x7f, 0x1c, 0xd7,
+	0x11, 0xb0, 0x1f, 0x2c, 0x14, 0xfd, 0x12, 0x7a, 0x11, 0x85, 0xbd, 0xfc, 0x5a, 0xee, 0x55, 0xc3,
+	0x5e, 0x81, 0xc5, 0x94, 0x68, 0x12, 0xff, 0x89, 0x14, 0x15, 0xfb, 0x44, 0xc7, 0x47, 0x17, 0x4c,
+	0xd2, 0xd8, 0x82, 0x29, 0xad, 0x44, 0x14, 0xef, 0xf4, 0x4a, 0x7c, 0x1b, 0x64, 0x8f, 0x8a, 0x0d,
+	0x82, 0x13, 0xbd, 0xeb, 0x0e, 0x9d, 0x40, 0xc9, 0x87, 0x7b, 0x3c, 0xcb, 0x71, 0x53, 0x0d, 0x5b,
+	0x92, 0xbb, 0x98, 0x85, 0xe4, 0x2e, 0xa6, 0xfa, 0x8b, 0x02, 0x40, 0x58, 0x2a, 0xe8, 0x1e, 0xcd,
+	0x16, 0xf9, 0x43, 0x28, 0x22, 0x23, 0xb6, 0x03, 0x91, 0x5b, 0xcb, 0x8d, 0x0f, 0xf3, 0x98, 0xd9,
+	0x9d, 0x6a, 0xf7, 0x88, 0xaf, 0x20, 0x0d, 0xfe, 0x30, 0xe6, 0x34, 0xf9, 0x57, 0xe8, 0x6f, 0x1b,
+	0xe4, 0x63, 0xc3, 0xb6, 0x4c, 0xbe, 0x20, 0x48, 0xa6, 0x5e, 0xeb, 0x13, 0x05, 0x78, 0x1e, 0x11,
+	0x70, 0x23, 0x2e, 0x1f, 0xa7, 0x01, 0x28, 0xd0, 0xd8, 0x9e, 0xfd, 0xa5, 0xb1, 0x10, 0x1c, 0x6d,
+	0xc8, 0xa6, 0x8a, 0xf5, 0x59, 0x71, 0x60, 0xfe, 0x15, 0xe2, 0xc0, 0xc2, 0x84, 0x38, 0x90, 0x9e,
+	0x7d, 0x78, 0x21, 0x3c, 0x9e, 0x7d, 0xd4, 0x37, 0x61, 0x41, 0xe8, 0x15, 0x17, 0x1c, 0x0d, 0xc7,
+	0xb4, 0x8e, 0x2d, 0x73, 0x68, 0xd8, 0xf2, 0x19, 0x7c, 0xaf, 0x0d, 0xfb, 0x43, 0x9b, 0xef, 0xa4,
+	0x4b, 0xea, 0xaf, 0x4b, 0xb0, 0x3c, 0xa2, 0x02, 0x72, 0x0d, 0x2e, 0xed, 0x8d, 0xec, 0xff, 0xd5,
+	0x5c, 0xcf, 0x1b, 0xb2, 0x75, 0x9c, 0x7c, 0x86, 0x9c, 0x07, 0xb2, 0x45, 0x13, 0x9b, 0x89, 0x8c,
+	0x4a, 0x96, 0xc8, 0x2a, 0xc8, 0xb5, 0x43, 0xda, 0x3d, 0xf2, 0x87, 0xfd, 0x1d, 0xcb, 0xef, 0x63,
+	0x7d, 0x4f, 0xce, 0x91, 0x8b, 0x70, 0x8e, 0x6d, 0x06, 0x6e, 0xd1, 0x36, 0xf5, 0x2c, 0xc3, 0xb6,
+	0xbe, 0xa0, 0x9c, 0x20, 0x4f, 0x56, 0x60, 0x79, 0x8b, 0x86, 0x9b, 0x6e, 0x1c, 0x58, 0x50, 0xff,
+	0x23, 0x0e, 0x47, 0xd5, 0xee, 0x51, 0x94, 0x78, 0xcd, 0xf4, 0xba, 0x2c, 0x5d, 0xe7, 0x5e, 0x41,
+	0xd7, 0xf9, 0x09, 0xba, 0xfe, 0xe5, 0x25, 0xf1, 0x23, 0x66, 0x9b, 0x1f, 0x35, 0xdb, 0x3e, 0x5c,
+	0x8e, 0x3a, 0x8e, 0xe6, 0xa9, 0x89, 0xce, 0xd5, 0x0e, 0xd9, 0xae, 0xfd, 0x4c, 0x0d, 0xa8, 0x50,
+	0xb2, 0x7c, 0xdd, 0x60, 0xb4, 0xe9, 0x0a, 0x70, 0xd1, 0xf2, 0x39, 0x4b, 0xf5, 0x79, 0x34, 0x21,
+	0x3e, 0xb6, 0xdd, 0x17, 0xb3, 0x79, 0xbe, 0x0e, 0x4b, 0x42, 0xfa, 0x5d, 0xea, 0xf5, 0xb9, 0x4e,
+	0x73, 0xeb, 0x15, 0x6d, 0x04, 0xaa, 0x76, 0x22, 0xa3, 0xed, 0x39, 0x7e, 0x54, 0x8a, 0x9a, 0xc9,
+	0x7e, 0xfa, 0x12, 0x44, 0xfd, 0x23, 0x29, 0x31, 0x7f, 0xd3, 0xa3, 0xaf, 0xca, 0xef, 0x2b, 0xcd,
+	0x48, 0x77, 0x61, 0x35, 0xa4, 0x4d, 0x1d, 0x26, 0x60, 0x53, 0x92, 0x46, 0x42, 0x7d, 0xc4, 0x67,
+	0x0a, 0xd4, 0x0f, 0x41, 0x11, 0xc2, 0x6b, 0xd4, 0xe8, 0x1e, 0x52, 0xb3, 0xee, 0x98, 0xad, 0x83,
+	0x4e, 0x98, 0x60, 0x4e, 0xed, 0x89, 0xfa, 0x3c, 0x2a, 0xcf, 0xd6, 0x6c, 0xd7, 0xa7, 0x51, 0xbe,
+	0x3a, 0x73, 0x42, 0x9b, 0xa1, 0xd2, 0x11, 0xbe, 0xa1, 0x8f, 0x7d, 0x65, 0x53, 0xfd, 0x8a, 0x04,
+	0xaf, 0x47, 0xbd, 0x15, 0x13, 0xcb, 0x9e, 0x63, 0x74, 0x8f, 0x1c, 0xf7, 0x05, 0x3b, 0x89, 0x63,
+	0x46, 0xd9, 0xd1, 0xcc, 0x4f, 0x7d, 0x04, 0xe5, 0xd8, 0x4c, 0xe8, 0x71, 0x33, 0x27, 0x01, 0x88,
+	0xec, 0xe4, 0xab, 0x3f, 0x88, 0x26, 0x59, 0xb1, 0x46, 0x1e, 0x11, 0x5d, 0x1a, 0xf5, 0x8a, 0x38,
+	0x5d, 0xce, 0xcd, 0x4e, 0x97, 0xd5, 0xff, 0x94, 0xe0, 0xfc, 0xc8, 0x22, 0xe2, 0x94, 0xdf, 0x19,
+	0x5b, 0x14, 0xe4, 0x32, 0x0e, 0xb3, 0xbc, 0x0d, 0xb2, 0x6d, 0x8c, 0x64, 0x3d, 0xe8, 0xa8, 0x79,
+	0x76, 0xea, 0x68, 0xc9, 0x36, 0x92, 0x39, 0x4f, 0xc6, 0x19, 0x85, 0x42, 0xd6, 0x19, 0x85, 0x91,
+	0x44, 0x79, 0x6e, 0x34, 0x51, 0x26, 0x6f, 0xc1, 0x52, 0x28, 0x85, 0xee, 0x51, 0xc3, 0x3c, 0x51,
+	0xe6, 0x13, 0xd9, 0x7d, 0x24, 0xb6, 0x86, 0x4d, 0xea, 0x4b, 0x58, 0x14, 0x0a, 0xe0, 0xf3, 0xc5,
+	0x8c, 0x6e, 0x47, 0x01, 0x34, 0xf7, 0xea, 0x09, 0x54, 0x3e, 0x9d, 0x40, 0x55, 0xa2, 0x70, 0xb0,
+	0x6b, 0x39, 0xbd, 0xe4, 0xab, 0xeb, 0xf4, 0x92, 0xae, 0x2d, 0x7c, 0x09, 0x8b, 0x8e, 0x33, 0xcd,
+	0x32, 0xab, 0x66, 0xad, 0xfe, 0x7b, 0x01, 0xae, 0x64, 0x31, 0xd6, 0xb2, 0x57, 0xd9, 0x63, 0x1f,
+	0x78, 0x1f, 0x80, 0x75, 0x4c, 0xef, 0xe2, 0xc2, 0x27, 0x37, 0x6b, 0x1a, 0x29, 0x31, 0xe4, 0x1a,
+	0x2e, 0x70, 0x6e, 0x42, 0x85, 0x53, 0xc6, 0xfa, 0x60, 0xcb, 0x48, 0x06, 0x0c, 0x53, 0xc8, 0x6b,
+	0x00, 0x7d, 0xbf, 0xa7, 0x19, 0x01, 0x6d, 0x89, 0x6d, 0x7c, 0x49, 0x4b, 0x40, 0xb0, 0x64, 0xd1,
+	0xf7, 0x7b, 0x62, 0x09, 0x3d, 0x18, 0x06, 0x88, 0x35, 0xc7, 0xb0, 0xc6, 0xe0, 0x02, 0x17, 0x29,
+	0xa3, 0x41, 0xac, 0xcc, 0x47, 0xb8, 0x29, 0x38, 0xee, 0x28, 0x24, 0xcb, 0xf2, 0x62, 0x8d, 0x9f,
+	0x82, 0x21, 0x3f, 0xe3, 0xd8, 0xb0, 0x6c, 0x2c, 0xb8, 0x87, 0x13, 0x08, 0x4f, 0x57, 0xc6, 0xe0,
+	0x64, 0x1d, 0x96, 0x87, 0x18, 0x30, 0xe2, 0x48, 0xc1, 0x96, 0x98, 0x05, 0x6d, 0x14, 0x4c, 0x36,
+	0xe1, 0xca, 0xbe, 0xed, 0x22, 0x28, 0xb4, 0x47, 0xcb, 0xd9, 0x13, 0x38, 0xbe, 0xd8, 0x9b, 0x2d,
+	0x6a, 0x53, 0x71, 0xd0, 0xc9, 0x0c, 0xd3, 0xf4, 0xa8, 0xef, 0xb3, 0x75, 0x63, 0x49, 0x0b, 0x5f,
+	0x71, 0xca, 0xeb, 0x86, 0xfb, 0x90, 0x6d, 0xcb, 0xe9, 0xf2, 0x83, 0x4b, 0x25, 0x6d, 0x04, 0x8a,
+	0xe7, 0x53, 0x58, 0x8a, 0x5b, 0x61, 0xad, 0xec, 0x19, 0x69, 0x85, 0x9e, 0xea, 0x2f, 0x07, 0x96,
+	0x47, 0x4d, 0x56, 0x13, 0x97, 0xb4, 0x11, 0xa8, 0xb0, 0xd9, 0xa6, 0xd1, 0x3d, 0xb2, 0xdd, 0x1e,
+	0xab, 0x7e, 0x17, 0xb4, 0x04, 0x44, 0xfd, 0x0c, 0x2e, 0x08, 0x8f, 0x7b, 0x42, 0x83, 0x6d, 0xc3,
+	0x4f, 0xec, 0x37, 0x7c, 0xd5, 0x40, 0x9d, 0xa8, 0x22, 0x8f, 0xf2, 0x8e, 0x1c, 0xba, 0x06, 0xcb,
+	0x2c, 0x08, 0x25, 0x26, 0x4b, 0x69, 0xf6, 0xca, 0xa3, 0x62, 0xa7, 0x04, 0x9d, 0x31, 0x17, 0xff,
+	0x1f, 0xb8, 0x1a, 0xf5, 0x03, 0x0f, 0x28, 0xe9, 0x26, 0xb5, 0x69, 0x40, 0xf5, 0x41, 0xb8, 0x79,
+	0x71, 0x8a, 0xe9, 0xf9, 0x52, 0xc8, 0x61, 0xc7, 0xf0, 0x8e, 0xb6, 0x18, 0x7d, 0x58, 0x47, 0x57,
+	0x7f, 0x37, 0x17, 0xa5, 0x53, 0x4f, 0x68, 0xc0, 0x66, 0x5d, 0xbf, 0x75, 0x80, 0x5e, 0xe9, 0x0f,
+	0x8c, 0xee, 0xcc, 0x41, 0x7b, 0x05, 0x4a, 0x4e, 0x88, 0x2b, 0x02, 0x75, 0x0c, 0x20, 0x4d, 0x28,
+	0xb0, 0x2a, 0x46, 0x7e, 0xca, 0x06, 0x4b, 0xd6, 0x57, 0xef, 0xb0, 0x9a, 0x06, 0xec, 0xd6, 0xb5,
+	0x76, 0xa3, 0xdd, 0xa9, 0x37, 0x3b, 0x1a, 0xe3, 0x83, 0x71, 0x9c, 0x45, 0x63, 0xdc, 0x83, 0x0f,
+	0x02, 0xea, 0x39, 0xa2, 0x60, 0x54, 0xe1, 0xd0, 0x5d, 0x0e, 0x8c, 0xe2, 0xb8, 0xaf, 0xe3, 0x2e,
+	0xa2, 0x48, 0x2f, 0x79, 0x1c, 0xf7, 0x9f, 0x1a, 0xfe, 0xa1, 0x7a, 0x1f, 0x0a, 0xf8, 0x05, 0x4c,
+	0xf3, 0xe3, 0x6f, 0xc8, 0x67, 0xf0, 0x10, 0x6d, 0xb3, 0xd5, 0xd4, 0x13, 0x30, 0x89, 0x2c, 0x40,
+	0xbe, 0xba, 0xbd, 0x2d, 0xe7, 0xd4, 0x3f, 0x95, 0xe0, 0xe6, 0x14, 0x99, 0x4f, 0x1b, 0xe6, 0xce,
+	0xc3, 0x3c, 0x97, 0x84, 0x4d, 0xd8, 0x25, 0x4d, 0xbc, 0x91, 0x1b, 0x50, 0x3c, 0xb0, 0xec, 0x80,
+	0xe2, 0x68, 0x48, 0x95, 0x90, 0x23, 0xf0, 0x68, 0xbf, 0x0a, 0xa3, 0xfd, 0xc2, 0xbd, 0xbc, 0x2e,
+	0xcb, 0x82, 0xc3, 0x42, 0x99, 0xd8, 0xcb, 0x13, 0x40, 0x4c, 0x14, 0xcf, 0x89, 0x2e, 0xb0, 0x73,
+	0x05, 0xac, 0x13, 0x58, 0xd7, 0x9d, 0x25, 0xf4, 0x55, 0x00, 0x71, 0x58, 0x21, 0xe1, 0xa4, 0x02,
+	0x32, 0xea, 0x05, 0xf9, 0x51, 0x2f, 0xc8, 0xb2, 0x5a, 0xee, 0x4b, 0x58, 0xed, 0x37, 0x24, 0xb8,
+	0x92, 0x29, 0xfd, 0x29, 0x13, 0x8b, 0x19, 0x9d, 0x88, 0x4a, 0x9c, 0x79, 0x66, 0x97, 0xb8, 0xc4,
+	0x99, 0xd6, 0x79, 0x6e, 0x44, 0xaa, 0x1f, 0x4b, 0x70, 0x61, 0x4c, 0xaa, 0xbd, 0x81, 0x69, 0x04,
+	0x74, 0xe4, 0x8b, 0xd2, 0xe8, 0x17, 0xaf, 0x02, 0x38, 0xf4, 0x85, 0x9e, 0x72, 0x87, 0x92, 0x43,
+	0x5f, 0x70, 0xdf, 0x42, 0xbd, 0xf1, 0xc1, 0x6e, 0x86, 0x28, 0x5c, 0xb2, 0x8a, 0x80, 0x0a, 0xb4,
+	0x99, 0x12, 0x7e, 0x0e, 0x97, 0xc6, 0x04, 0x44, 0xb5, 0xb1, 0xcc, 0xf6, 0xab, 0x29, 0x4d, 0x75,
+	0xa2, 0x62, 0xd2, 0x13, 0x1a, 0x88, 0x2b, 0x02, 0x33, 0x38, 0x46, 0x7a, 0xce, 0x25, 0x4b, 0xc9,
+	0xe3, 0x29, 0x5a, 0x3e, 0x23, 0x45, 0x53, 0xff, 0x55, 0x02, 0x65, 0xf4, 0x83, 0xdf, 0x90, 0x04,
+	0x23, 0xce, 0x8f, 0x0b, 0xa7, 0x28, 0x27, 0x8f, 0xf7, 0x77, 0x2e, 0xab, 0xbf, 0xff, 0x37, 0xd9,
+	0xdd, 0x96, 0xc7, 0x76, 0x7a, 0xe9, 0x57, 0xd1, 0x73, 0x2c, 0x65, 0x7e, 0x2d, 0x37, 0x4b, 0x4a,
+	0xf5, 0xa7, 0x12, 0xac, 0x4d, 0xfa, 0xfe, 0x37, 0x44, 0xed, 0xa7, 0xcb, 0xed, 0xd5, 0x1f, 0x41,
+	0x45, 0x74, 0xa4, 0x49, 0x5f, 0x74, 0x5e, 0x3a, 0xb3, 0xa4, 0xe6, 0xa5, 0x0f, 0x3d, 0x08, 0x6c,
+	0xdc, 0x32, 0x77, 0x1d, 0x33, 0x51, 0x26, 0xc1, 0xd2, 0x47, 0x27, 0xb0, 0xdb, 0x1c, 0x4e, 0xce,
+	0xc3, 0x5c, 0xd0, 0x0d, 0x17, 0x20, 0x0c, 0xa1, 0x10, 0x74, 0x1b, 0xa6, 0xfa, 0xb3, 0x38, 0xda,
+	0xf2, 0x6f, 0x9e, 0x56, 0x63, 0xdf, 0xfc, 0x1a, 0x8d, 0xfa, 0xfb, 0xf1, 0x38, 0xac, 0x9a, 0xf1,
+	0xbe, 0x6f, 0xc7, 0x3d, 0x85, 0x6a, 0xff, 0xbb, 0xba, 0x97, 0xde, 0xe4, 0x2e, 0xb0, 0x58, 0x99,
+	0x80, 0xa8, 0xff, 0x18, 0x3b, 0xf3, 0x98, 0xcc, 0xdf, 0x22, 0xd3, 0x3c, 0x85, 0xc5, 0xe4, 0x89,
+	0x92, 0x2f, 0x7f, 0xd0, 0x49, 0xfd, 0x3b, 0x29, 0x2e, 0xb5, 0x99, 0x66, 0x92, 0xe9, 0xd7, 0x6a,
+	0xe7, 0xff, 0x31, 0x22, 0x7a, 0x21, 0xab, 0xd8, 0x9c, 0x94, 0x76, 0xa4, 0x5b, 0xff, 0x12, 0x27,
+	0x72, 0x59, 0xdd, 0xfa, 0x16, 0xb9, 0xc2, 0x1f, 0x4b, 0x51, 0xd4, 0xab, 0x3b, 0xe6, 0xd7, 0x68,
+	0xb2, 0x87, 0x00, 0x18, 0x4d, 0x8d, 0xae, 0x30, 0x18, 0x76, 0xec, 0x42, 0xba, 0x63, 0x9d, 0x97,
+	0x4e, 0x95, 0x35, 0x6b, 0xa5, 0x20, 0x7c, 0x4c, 0x86, 0x50, 0xde, 0x81, 0x6f, 0x91, 0x71, 0x7e,
+	0x2f, 0x07, 0x4a, 0xaa, 0x6f, 0x2d, 0x27, 0x8a, 0x49, 0x5f, 0x57, 0xf7, 0xa2, 0x58, 0xc1, 0xd7,
+	0x16, 0xfc, 0x65, 0xc4, 0x7a, 0x73, 0xa7, 0xb5, 0x1e, 0x79, 0x02, 0x37, 0x46, 0xa5, 0xd4, 0xdd,
+	0x03, 0xdd, 0x76, 0x5f, 0xe0, 0xd1, 0x67, 0xea, 0xe1, 0x7a, 0x56, 0xd4, 0xf7, 0xaf, 0xa4, 0x65,
+	0x6e, 0x1d, 0x6c, 0xbb, 0x2f, 0x3e, 0x0d, 0x71, 0x92, 0x91, 0x7b, 0x4c, 0x55, 0xdf, 0x22, 0x8f,
+	0xf8, 0xfb, 0x78, 0x2d, 0x1e, 0x76, 0x33, 0x15, 0xc9, 0xbf, 0x31, 0xf1, 0x56, 0x7a, 0x95, 0x78,
+	0xfb, 0xf5, 0xbb, 0x4f, 0x22, 0xe0, 0x67, 0xe9, 0xf5, 0x5b, 0xe4, 0x41, 0xbf, 0xf6, 0x36, 0x94,
+	0x37, 0x0d, 0x9f, 0x8a, 0xde, 0x92, 0x0d, 0x51, 0x7c, 0xe3, 0x87, 0xb5, 0xaf, 0xa5, 0x39, 0x27,
+	0x10, 0xd3, 0xb7, 0xaf, 0x17, 0x44, 0x09, 0x4f, 0x14, 0xfa, 0xaf, 0x64, 0xd6, 0x6d, 0xc4, 0xd9,
+	0x21, 0x2d, 0x44, 0x26, 0x1f, 0x41, 0x49, 0x3c, 0xd2, 0x70, 0xd3, 0xe8, 0xda, 0x34, 0x4a, 0x6a,
+	0x6a, 0x31, 0x01, 0x52, 0x47, 0x1b, 0x62, 0x4a, 0x61, 0x0a, 0x75, 0x74, 0x20, 0x57, 0x8b, 0x09,
+	0xc8, 0x07, 0x50, 0x8c, 0x6e, 0x63, 0xcc, 0x31, 0xe2, 0xab, 0x99, 0xc4, 0xe1, 0x56, 0x84, 0x16,
+	0xa1, 0xe3, 0xdd, 0x74, 0x1f, 0x2f, 0xf4, 0xce, 0x33, 0xb2, 0x8b, 0xd9, 0xdf, 0xc4, 0x03, 0x1f,
+	0x0c, 0x8d, 0xd4, 0x60, 0x11, 0x7f, 0x75, 0x8f, 0x9f, 0xff, 0x10, 0x47, 0x87, 0xd6, 0x26, 0x93,
+	0x71, 0x3c, 0xad, 0xec, 0xc7, 0x2f, 0xe4, 0x63, 0x00, 0xc6, 0x84, 0x9b, 0xbd, 0x38, 0xad, 0xb7,
+	0xe1, 0x11, 0x0d, 0xad, 0xe4, 0x87, 0x8f, 0x68, 0xa1, 0xd0, 0xfe, 0xa5, 0x29, 0x16, 0x0a, 0xcf,
+	0xf5, 0x86, 0xc8, 0xe4, 0x36, 0xe4, 0x8d, 0xee, 0x91, 0xb8, 0x93, 0xa3, 0x4c, 0xda, 0xeb, 0xd7,
+	0x10, 0x09, 0xd5, 0x72, 0x60, 0xbb, 0x2f, 0x94, 0xf2, 0x14, 0xb5, 0xe0, 0xde, 0xa8, 0xc6, 0xd0,
+	0xc8, 0x26, 0x94, 0x87, 0xf1, 0x8e, 0xa6, 0xb2, 0x38, 0x45, 0x2b, 0x89, 0x9d, 0x4f, 0x2d, 0x49,
+	0x84, 0xdd, 0xf2, 0x79, 0x25, 0x47, 0xa9, 0x4c, 0xe9, 0x96, 0xa8, 0xf6, 0x68, 0x21, 0x32, 0xb9,
+	0x1b, 0x8e, 0x9f, 0xa5, 0xac, 0xc0, 0x94, 0xdc, 0x83, 0x09, 0x07, 0x50, 0x03, 0xaf, 0x97, 0xb8,
+	0x3e, 0x8d, 0xaf, 0xf0, 0x2c, 0x33, 0x52, 0x35, 0xdb, 0x5f, 0x93, 0x3b, 0x8b, 0x78, 0x05, 0x25,
+	0xf1, 0x1a, 0xb3, 0x0a, 0x6b, 0xac, 0x8a, 0x3c, 0x8b, 0x55, 0x58, 0x69, 0x17, 0xac, 0xc2, 0x57,
+	0xd2, 0x62, 0xb7, 0x3e, 0x18, 0x5b, 0x3d, 0x54, 0x04, 0x3f, 0x6f, 0xfd, 0xda, 0x54, 0x67, 0x0e,
+	0x15, 0xb2, 0x3c, 0x48, 0x03, 0xd0, 0x86, 0x03, 0xcb, 0xe9, 0x29, 0x64, 0x8a, 0x0d, 0x71, 0x87,
+	0x48, 0x63, 0x68, 0x0c, 0xdd, 0x75, 0x7a, 0xca, 0xca, 0x34, 0x74, 0x97, 0xa1, 0xbb, 0x4e, 0x8f,
+	0xfc, 0x3f, 0xb8, 0xee, 0x4d, 0xdf, 0xc2, 0x64, 0x17, 0x6b, 0xcb, 0x1b, 0x0f, 0x32, 0x39, 0xcd,
+	0xd8, 0xfe, 0xd4, 0x66, 0x31, 0x27, 0xff, 0x0b, 0xce, 0x46, 0x8b, 0xbb, 0xf0, 0xa0, 0xb1, 0x72,
+	0x8e, 0x7d, 0xf1, 0x9d, 0x57, 0x3b, 0x9d, 0x3c, 0xce, 0x87, 0xf8, 0x70, 0x71, 0x0c, 0x18, 0xce,
+	0x13, 0xec, 0x26, 0x70, 0x79, 0xe3, 0xdd, 0x2f, 0x75, 0x04, 0x5a, 0x9b, 0xcc, 0x17, 0x07, 0x91,
+	0x1d, 0x1f, 0x59, 0x55, 0x2e, 0x4c, 0x19, 0x44, 0xc9, 0xa3, 0xad, 0x49, 0x22, 0xf2, 0x39, 0xac,
+	0xd8, 0xe3, 0xc7, 0x5e, 0xd9, 0x3d, 0xe3, 0xf2, 0xc6, 0xfa, 0x4c, 0x5e, 0xa1, 0x94, 0x59, 0x4c,
+	0xc8, 0xd3, 0xf8, 0xda, 0x09, 0xdb, 0xd9, 0x53, 0x2e, 0x4e, 0x73, 0xf5, 0x24, 0xa6, 0x96, 0x26,
+	0x24, 0x3f, 0x84, 0x73, 0xdd, 0xac, 0x3d, 0x42, 0x76, 0x8b, 0xb9, 0xbc, 0x71, 0xfb, 0x14, 0x1c,
+	0x43, 0x49, 0xb3, 0x19, 0x91, 0x0e, 0x9c, 0xf5, 0x46, 0x8f, 0x13, 0xb0, 0x0b, 0xd0, 0xe5, 0x09,
+	0xd7, 0x75, 0xc6, 0x0e, 0x1f, 0x68, 0xe3, 0x0c, 0xf8, 0x64, 0x41, 0x8f, 0x94, 0x2b, 0x53, 0x86,
+	0x08, 0x1e, 0xc1, 0xd0, 0x18, 0x1a, 0xf9, 0x3e, 0xc8, 0xbd, 0x91, 0xcd, 0x23, 0x76, 0x5f, 0xba,
+	0xbc, 0x71, 0x6b, 0xd2, 0x5e, 0x48, 0x0a, 0x59, 0x1b, 0x23, 0x27, 0x16, 0x28, 0xbd, 0x09, 0xfb,
+	0x51, 0xca, 0xb5, 0x29, 0xce, 0x3f, 0x69, 0x13, 0x4b, 0x9b, 0xc8, 0x8e, 0xe8, 0x70, 0x9e, 0x9f,
+	0x92, 0x89, 0x62, 0x9b, 0xce, 0x37, 0x12, 0x94, 0xeb, 0xec, 0x43, 0x6f, 0x4e, 0x98, 0x41, 0xc6,
+	0x0f, 0xe5, 0x68, 0xab, 0x46, 0x06, 0x94, 0xfc, 0x00, 0x56, 0x7b, 0x19, 0x3b, 0x29, 0xca, 0xda,
+	0x14, 0xf6, 0x99, 0x5b, 0x2f, 0x99, 0x6c, 0xc8, 0x10, 0xae, 0xf4, 0xa6, 0x6c, 0xd4, 0x28, 0x37,
+	0xd8, 0x67, 0xee, 0x9d, 0xfe, 0x33, 0xa1, 0xca, 0xa6, 0xb2, 0xc5, 0x4c, 0xa6, 0x17, 0x96, 0xa6,
+	0x15, 0x75, 0xca, 0xdc, 0x1e, 0x17, 0xb0, 0x63, 0x02, 0xf4, 0xdb, 0xde, 0x68, 0x61, 0x5b, 0xb9,
+	0x39, 0xc5, 0x6f, 0xc7, 0xca, 0xe0, 0xda, 0x38, 0x03, 0x1c, 0xb9, 0x46, 0xf2, 0xfa, 0xa2, 0xf2,
+	0xda, 0x94, 0x91, 0x9b, 0xba, 0xe8, 0xa8, 0xa5, 0x09, 0x49, 0x1d, 0x16, 0x8d, 0xc4, 0x4d, 0x4d,
+	0xe5, 0x16, 0x63, 0x74, 0x63, 0x22, 0xa3, 0x48, 0xaa, 0x14, 0x19, 0x86, 0x3a, 0x23, 0x3e, 0xb6,
+	0xa6, 0xbc, 0x3e, 0x25, 0xd4, 0x25, 0x8e, 0xb7, 0x69, 0x49, 0x22, 0xa1, 0xaa, 0x74, 0x51, 0x5a,
+	0x79, 0x63, 0xba, 0xaa, 0xd2, 0xd8, 0xda, 0x38, 0x03, 0x62, 0xc3, 0xc5, 0xde, 0xa4, 0x52, 0xb7,
+	0xb2, 0xce, 0xb8, 0xdf, 0x39, 0x25, 0xf7, 0x28, 0xe4, 0x4f, 0x64, 0x48, 0xee, 0xc3, 0xbc, 0xc3,
+	0x6a, 0xc3, 0xca, 0x46, 0xd6, 0x3e, 0x6e, 0xba, 0x7c, 0x2c, 0x50, 0xc9, 0x33, 0x58, 0x72, 0x52,
+	0x05, 0x65, 0xe5, 0x3e, 0x23, 0xbe, 0x39, 0x8d, 0x38, 0x14, 0x66, 0x84, 0x14, 0xb5, 0x68, 0x8c,
+	0x56, 0x43, 0x95, 0x07, 0x53, 0xb4, 0x38, 0x5e, 0x3b, 0x1d, 0x67, 0x80, 0x5a, 0x34, 0x26, 0xd5,
+	0x58, 0x95, 0x77, 0xa7, 0x68, 0x71, 0x62, 0x65, 0x56, 0x9b, 0xcc, 0x10, 0x03, 0x89, 0x91, 0x51,
+	0xc9, 0x53, 0x1e, 0x4e, 0x8b, 0x53, 0x19, 0x04, 0x5a, 0x26, 0x1b, 0x0c, 0x24, 0xc6, 0x94, 0x42,
+	0xa1, 0xf2, 0xde, 0x94, 0x40, 0x32, 0xad, 0xc2, 0xa8, 0x4d, 0x65, 0x8b, 0xbe, 0x41, 0xd9, 0x72,
+	0x55, 0x79, 0x7f, 0x8a, 0x6f, 0x88, 0xba, 0x98, 0x40, 0x45, 0xdf, 0xa0, 0xa9, 0x4a, 0x99, 0xf2,
+	0xc1, 0x14, 0xdf, 0x48, 0x17, 0xd5, 0xb4, 0x11, 0x52, 0xf4, 0x0d, 0x3a, 0x5a, 0x6f, 0x51, 0x1e,
+	0x4d, 0xf1, 0x8d, 0xf1, 0xea, 0xcc, 0x38, 0x03, 0xf4, 0x0d, 0x3a, 0xa9, 0x8a, 0xa3, 0x7c, 0x38,
+	0xc5, 0x37, 0x26, 0xd6, 0x7e, 0xb4, 0xc9, 0x0c, 0xd1, 0x37, 0x68, 0xc6, 0xa2, 0x5f, 0xf9, 0x68,
+	0x8a, 0x6f, 0x64, 0x56, 0x09, 0x32, 0xd9, 0xa0, 0x6f, 0xd0, 0x29, 0x35, 0x05, 0xe5, 0xe3, 0x29,
+	0xbe, 0x31, 0xad, 0x18, 0xa1, 0x4d, 0x65, 0x8b, 0x66, 0x7e, 0x91, 0xda, 0xcc, 0x55, 0x3e, 0x99,
+	0x62, 0xe6, 0xf4, 0xbe, 0xaf, 0x36, 0x42, 0x8a, 0xd9, 0xd8, 0x8b, 0xac, 0x0d, 0x75, 0xa5, 0x3a,
+	0x25, 0x1b, 0xcb, 0xdc, 0x82, 0xd7, 0xb2, 0x19, 0x61, 0x22, 0xf4, 0x62, 0x64, 0x73, 0x5c, 0xd9,
+	0x9c, 0x92, 0x08, 0x8d, 0xee, 0xa4, 0x6b, 0x63, 0xe4, 0x98, 0xe8, 0xbe, 0x18, 0xdf, 0xce, 0x56,
+	0x6a, 0x53, 0x12, 0xdd, 0x8c, 0xed, 0x6f, 0x2d, 0x8b, 0x89, 0xfa, 0xd3, 0x92, 0xf8, 0x23, 0x39,
+	0xbc, 0x23, 0xd5, 0x6a, 0x36, 0xeb, 0xb5, 0x8e, 0x9c, 0xc3, 0xeb, 0xab, 0xe2, 0xa5, 0xbe, 0x25,
+	0xe7, 0xf1, 0xb5, 0xbd, 0xb7, 0xd9, 0xae, 0x69, 0x8d, 0xcd, 0xba, 0x5c, 0x60, 0xff, 0x29, 0xa7,
+	0xb5, 0xb6, 0xf6, 0x6a, 0x75, 0x8d, 0xff, 0x7f, 0x5c, 0xbb, 0xde, 0xdc, 0x92, 0xe7, 0x89, 0x0c,
+	0x8b, 0xf8, 0xa4, 0x6b, 0xf5, 0x5a, 0xbd, 0xb1, 0xdb, 0x91, 0x17, 0xf0, 0xd4, 0x09, 0x83, 0xd4,
+	0x35, 0xad, 0xa5, 0xc9, 0x45, 0xfc, 0xc8, 0x4e, 0xbd, 0xdd, 0xae, 0x3e, 0xa9, 0xcb, 0x25, 0x76,
+	0xdc, 0xa4, 0xf6, 0x4c, 0x06, 0xe4, 0xf0, 0x78, 0xbb, 0xf5, 0xa9, 0x5c, 0x26, 0xcb, 0x50, 0xde,
+	0x6b, 0xc6, 0x9f, 0x5a, 0x44, 0x82, 0xf6, 0x5e, 0xad, 0x56, 0x6f, 0xb7, 0xe5, 0x0a, 0xfe, 0xfd,
+	0x1c, 0x67, 0xb4, 0x84, 0xc7, 0x57, 0x6a, 0xdb, 0xad, 0x76, 0x5d, 0x8f, 0x04, 0x59, 0x8e, 0x61,
+	0xb5, 0x56, 0xb3, 0xbd, 0xb7, 0x53, 0xd7, 0x64, 0x19, 0x4f, 0xa0, 0x87, 0x18, 0x7a, 0xc8, 0xe8,
+	0x2c, 0x7e, 0x70, 0xb7, 0xd1, 0x7c, 0x22, 0x13, 0xf6, 0xd4, 0x6a, 0x3e, 0x91, 0x57, 0xc8, 0x2d,
+	0xb8, 0xa1, 0xd5, 0xb7, 0xea, 0xdb, 0x8d, 0xe7, 0x75, 0x4d, 0xdf, 0x6b, 0x56, 0x6b, 0xcf, 0x9a,
+	0xad, 0x4f, 0xb7, 0xeb, 0x5b, 0x4f, 0xea, 0x5b, 0xba, 0x90, 0xb9, 0x2d, 0xaf, 0x12, 0x05, 0x56,
+	0x77, 0xab, 0x5a, 0xa7, 0xd1, 0x69, 0xb4, 0x9a, 0xac, 0xa5, 0x53, 0xdd, 0xaa, 0x76, 0xaa, 0xf2,
+	0x39, 0x72, 0x03, 0xae, 0x66, 0xb5, 0xe8, 0x5a, 0xbd, 0xbd, 0xdb, 0x6a, 0xb6, 0xeb, 0xf2, 0x79,
+	0x76, 0x93, 0xb7, 0xd5, 0x7a, 0xb6, 0xb7, 0x2b, 0x5f, 0xc0, 0xa3, 0xee, 0xfc, 0x39, 0x46, 0x50,
+	0x58, 0x17, 0x84, 0xf0, 0x7a, 0xbb, 0x53, 0xed, 0xb4, 0xe5, 0x8b, 0xe4, 0x32, 0x5c, 0x48, 0xc3,
+	0x62, 0x82, 0x4b, 0x28, 0x8e, 0x56, 0xaf, 0xd6, 0x9e, 0xd6, 0xb7, 0x74, 0xd4, 0x73, 0xeb, 0xb1,
+	0xde, 0x69, 0xed, 0x36, 0x6a, 0xf2, 0x65, 0x6e, 0x96, 0xfa, 0x33, 0xf9, 0x0a, 0xb9, 0x00, 0x2b,
+	0x4f, 0xea, 0x1d, 0x7d, 0xbb, 0xda, 0xee, 0x84, 0x3d, 0xd1, 0x1b, 0x5b, 0xf2, 0x55, 0xb2, 0x06,
+	0x57, 0x32, 0x1a, 0x62, 0xf6, 0xd7, 0xc8, 0x25, 0x38, 0x5f, 0xad, 0x75, 0x1a, 0xcf, 0x63, 0x9d,
+	0xea, 0xb5, 0xa7, 0xd5, 0xe6, 0x93, 0xba, 0x7c, 0x1d, 0xe5, 0x42, 0x6a, 0xf6, 0xbd, 0x36, 0x7e,
+	0xb9, 0x59, 0xdd, 0xa9, 0xb7, 0x77, 0xab, 0xb5, 0xba, 0xbc, 0x46, 0x5e, 0x83, 0xb5, 0x09, 0x8d,
+	0x31, 0xfb, 0x1b, 0xe8, 0x1e, 0x88, 0xd5, 0xae, 0x3d, 0xad, 0xef, 0x54, 0x65, 0x35, 0x94, 0x94,
+	0xbf, 0xc7, 0x88, 0x37, 0x51, 0x2f, 0xd5, 0xbd, 0xce, 0x53, 0xfc, 0xf8, 0xf6, 0x76, 0x1d, 0xbf,
+	0xff, 0x1a, 0xfe, 0x33, 0x20, 0x83, 0x45, 0x68, 0xb7, 0xd0, 0x01, 0xab, 0xb5, 0x67, 0x31, 0xe4,
+	0x75, 0xd4, 0x0f, 0x72, 0x6c, 0x69, 0x7a, 0x4d, 0xab, 0x57, 0x3b, 0xf5, 0xf0, 0x5b, 0x6f, 0xa0,
+	0xb9, 0xb2, 0x5a, 0x62, 0xe2, 0x75, 0x74, 0xbe, 0x66, 0xfd, 0x53, 0xbd, 0xf3, 0x3f, 0x9b, 0xf2,
+	0x06, 0x7a, 0x92, 0x78, 0x89, 0x51, 0xee, 0x23, 0xff, 0xea, 0xd6, 0x96, 0x1e, 0x19, 0x5e, 0xef,
+	0xb4, 0x18, 0xfe, 0x03, 0xe4, 0x9f, 0xd5, 0x12, 0x13, 0xbf, 0x8b, 0x1a, 0x44, 0x14, 0xe1, 0xef,
+	0xbb, 0x49, 0xfa, 0x87, 0xa8, 0xc1, 0x09, 0x8d, 0x31, 0x8b, 0xf7, 0x50, 0x44, 0xb4, 0x3b, 0x92,
+	0xbc, 0x8f, 0x22, 0x8a, 0x97, 0x18, 0xe5, 0x03, 0x14, 0x31, 0x84, 0xb6, 0x9a, 0xb1, 0x3c, 0xf2,
+	0x23, 0x14, 0x31, 0xab, 0x25, 0x26, 0xfe, 0x10, 0x45, 0x4c, 0xa0, 0x24, 0x85, 0x91, 0x3f, 0x42,
+	0x11, 0x27, 0x34, 0xc6, 0x2c, 0x3e, 0x46, 0xa9, 0x3e, 0xad, 0x76, 0x6a, 0x4f, 0xb9, 0x33, 0xe8,
+	0xdb, 0x8d, 0x76, 0x47, 0xfe, 0x84, 0x5c, 0x01, 0x65, 0x14, 0x1a, 0x0d, 0xd0, 0x2a, 0x5e, 0x28,
+	0x49, 0xb6, 0xee, 0xed, 0x6e, 0x55, 0x3b, 0x75, 0x79, 0x13, 0xfd, 0x71, 0x8c, 0x8a, 0x8d, 0x79,
+	0xb9, 0x76, 0x7b, 0x8b, 0x5d, 0xe5, 0x4c, 0xfe, 0xcd, 0x1d, 0xfb, 0x73, 0xcc, 0x56, 0xb3, 0x2e,
+	0x9f, 0xc1, 0x58, 0xb3, 0xfd, 0xf9, 0x03, 0xfe, 0xcf, 0x98, 0x9f, 0x6f, 0x37, 0x36, 0xe5, 0x1c,
+	0x7b, 0x6a, 0x77, 0x30, 0xbc, 0xe1, 0xed, 0xfc, 0x66, 0x75, 0x77, 0xf7, 0x33, 0xb9, 0x70, 0xbb,
+	0x0e, 0x64, 0xfc, 0xce, 0x61, 0xe2, 0xfe, 0xfe, 0x99, 0xf4, 0xd5, 0x7e, 0x76, 0xc7, 0xe5, 0x53,
+	0xc3, 0x0a, 0x1e, 0xbb, 0x5e, 0x0c, 0xcd, 0xdd, 0xfe, 0xff, 0x73, 0x50, 0x4e, 0x94, 0xb3, 0xd1,
+	0x33, 0xf7, 0x1c, 0xac, 0xec, 0x88, 0x5b, 0x2d, 0x67, 0xd0, 0x7d, 0xc3, 0xaa, 0x48, 0xe2, 0xba,
+	0xcc, 0x2e, 0xf5, 0x7c, 0x76, 0xf9, 0xb8, 0x2b, 0xee, 0xc4, 0xe4, 0x70, 0x50, 0xe0, 0xea, 0x82,
+	0x3a, 0x81, 0xd5, 0x8d, 0xef, 0xe4, 0xc8, 0x79, 0x54, 0x52, 0x95, 0xdf, 0xc7, 0xfd, 0x22, 0x01,
+	0x2f, 0xe0, 0xb7, 0xc2, 0xd5, 0xe7, 0xe6, 0xd0, 0x3f, 0x91, 0xe7, 0x30, 0xd6, 0x88, 0x9b, 0xb2,
+	0x4d, 0x37, 0x60, 0x47, 0xb6, 0xe5, 0x79, 0x0c, 0x78, 0x61, 0x4f, 0x37, 0xf9, 0xb9, 0xd8, 0xef,
+	0x0f, 0xdd, 0xc0, 0xa8, 0xbf, 0xec, 0x52, 0x6a, 0x52, 0x5e, 0x45, 0x94, 0x17, 0xc8, 0x9b, 0x70,
+	0x6b, 0x2a, 0xda, 0xcb, 0x2e, 0xe5, 0xd7, 0x80, 0x8a, 0xd8, 0xa5, 0xf0, 0xba, 0x0f, 0xa7, 0x2e,
+	0xa1, 0xff, 0xec, 0x39, 0xe2, 0x5f, 0x7e, 0xa8, 0x29, 0x4e, 0xa8, 0xf0, 0x46, 0x40, 0x7c, 0x36,
+	0x1b, 0x35, 0xdd, 0xe0, 0xb1, 0x3b, 0x74, 0x4c, 0xb9, 0x8c, 0xce, 0x9a, 0x4c, 0x02, 0xa2, 0x96,
+	0x45, 0x76, 0x97, 0x28, 0x3c, 0x48, 0x1c, 0x42, 0x2b, 0xd8, 0xb3, 0x8e, 0xeb, 0xee, 0x18, 0xce,
+	0x89, 0xc6, 0x37, 0x2f, 0x7c, 0x79, 0x09, 0x99, 0x30, 0xbe, 0x1d, 0xea, 0xf5, 0x2d, 0xc7, 0x08,
+	0xc2, 0xce, 0x2c, 0xa3, 0x6a, 0xa2, 0xce, 0xa0, 0x6a, 0xd8, 0x04, 0xd1, 0x70, 0xd8, 0x0d, 0x2f,
+	0x2e, 0x8a, 0xd1, 0xc7, 0x3f, 0x21, 0x3d, 0x0f, 0xa4, 0xc1, 0x2e, 0x3c, 0x19, 0x81, 0xb5, 0x6f,
+	0x8b, 0x95, 0x8c, 0x4c, 0xd0, 0x16, 0xa1, 0x10, 0x55, 0xdf, 0xb7, 0x7a, 0xa2, 0x2b, 0x2b, 0x44,
+	0x85, 0x6b, 0x1d, 0xcf, 0x70, 0x7c, 0xbe, 0xf3, 0x53, 0x73, 0x5d, 0xcf, 0xc4, 0x2f, 0xbb, 0xb1,
+	0xac, 0xab, 0xc9, 0x4f, 0xbd, 0x64, 0xff, 0x7e, 0x31, 0xf4, 0xe5, 0x73, 0xd8, 0x83, 0xa6, 0x1b,
+	0x54, 0xf1, 0xff, 0x5c, 0x42, 0x39, 0xcf, 0xe3, 0x77, 0x52, 0xec, 0x9c, 0x03, 0xdb, 0xea, 0x06,
+	0xf2, 0x85, 0x91, 0x86, 0x88, 0xb9, 0x22, 0xfe, 0x14, 0x95, 0xf5, 0xec, 0x31, 0x7a, 0x8f, 0x29,
+	0x5f, 0xbc, 0xfd, 0x0c, 0x20, 0x71, 0x03, 0x1e, 0x63, 0x68, 0xf4, 0x26, 0xfe, 0x33, 0x76, 0x05,
+	0x96, 0x63, 0xd8, 0x67, 0x5d, 0xe3, 0xf9, 0x3d, 0xee, 0x86, 0x31, 0xb0, 0x8a, 0x9e, 0xe7, 0xcb,
+	0xb9, 0xdb, 0x7f, 0x20, 0xc1, 0xf2, 0xee, 0xc8, 0xdf, 0x27, 0xcd, 0x43, 0xee, 0xf8, 0xae, 0x7c,
+	0x86, 0xfd, 0x22, 0x25, 0xfe, 0x6e, 0xc8, 0x39, 0xf6, 0x7b, 0x5f, 0xce, 0xb3, 0xdf, 0x07, 0x72,
+	0x81, 0xfd, 0xbe, 0x2b, 0xcf, 0xb1, 0xdf, 0x87, 0xf2, 0x3c, 0xfb, 0x7d, 0x4f, 0x5e, 0x60, 0xbf,
+	0xef, 0xcb, 0x45, 0xf6, 0xfb, 0x01, 0xcf, 0x08, 0x8e, 0xef, 0xdd, 0x95, 0x81, 0x3f, 0xdc, 0x93,
+	0xcb, 0xfc, 0x61, 0x43, 0x5e, 0xe4, 0x0f, 0xf7, 0xe5, 0x0a, 0x7f, 0x78, 0x20, 0x2f, 0xf1, 0x87,
+	0x77, 0xe5, 0x65, 0xfe, 0xf0, 0x50, 0x96, 0xf9, 0xc3, 0x7b, 0xf2, 0x59, 0xfe, 0xf0, 0xbe, 0x4c,
+	0x6e, 0xbf, 0x95, 0xfc, 0x6f, 0x1f, 0x71, 0x0c, 0xb6, 0xba, 0xd7, 0x69, 0xe9, 0xed, 0xdd, 0xed,
+	0x46, 0x47, 0xfc, 0x31, 0x47, 0xa7, 0x51, 0x7b, 0xf6, 0x99, 0x2c, 0xdd, 0x56, 0xa1, 0x14, 0x6d,
+	0xce, 0x61, 0x43, 0xad, 0xb5, 0xb3, 0xc3, 0x90, 0x4a, 0x30, 0x57, 0xdd, 0x6c, 0x69, 0x1d, 0x59,
+	0xda, 0xdc, 0xf8, 0xcb, 0x9f, 0x5f, 0x93, 0xfe, 0xfa, 0xe7, 0xd7, 0xa4, 0x7f, 0xf8, 0xf9, 0x35,
+	0x09, 0x54, 0xd7, 0xeb, 0xdd, 0x31, 0x06, 0x58, 0x3a, 0x0b, 0xb3, 0xb0, 0xae, 0xdb, 0xef, 0xbb,
+	0xce, 0x1d, 0x23, 0xfc, 0x7b, 0xe1, 0xa7, 0xf9, 0xff, 0x1a, 0x00, 0x7f, 0x31, 0xfe, 0x68, 0x72,
+	0x58, 0x00, 0x00,
 }
 
 func (m *Schema) Marshal() (dAtA []byte, err error) {
@@ -7934,6 +8353,26 @@ func (m *FeatureFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SupportsTopicWatchers != nil {
+		i--
+		if *m.SupportsTopicWatchers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SupportsPartialProducer != nil {
+		i--
+		if *m.SupportsPartialProducer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SupportsBrokerEntryMetadata != nil {
 		i--
 		if *m.SupportsBrokerEntryMetadata {
@@ -7981,6 +8420,18 @@ func (m *CommandConnected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FeatureFlags != nil {
+		{
+			size, err := m.FeatureFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPulsarApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MaxMessageSize != nil {
 		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.MaxMessageSize))
 		i--
@@ -10043,6 +10494,20 @@ func (m *CommandGetTopicsOfNamespace) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TopicsHash != nil {
+		i -= len(*m.TopicsHash)
+		copy(dAtA[i:], *m.TopicsHash)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TopicsPattern != nil {
+		i -= len(*m.TopicsPattern)
+		copy(dAtA[i:], *m.TopicsPattern)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsPattern)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Mode != nil {
 		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.Mode))
 		i--
@@ -10091,6 +10556,33 @@ func (m *CommandGetTopicsOfNamespaceResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Changed != nil {
+		i--
+		if *m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TopicsHash != nil {
+		i -= len(*m.TopicsHash)
+		copy(dAtA[i:], *m.TopicsHash)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filtered != nil {
+		i--
+		if *m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Topics) > 0 {
 		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Topics[iNdEx])
@@ -10110,6 +10602,233 @@ func (m *CommandGetTopicsOfNamespaceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *CommandWatchTopicList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandWatchTopicList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandWatchTopicList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TopicsHash != nil {
+		i -= len(*m.TopicsHash)
+		copy(dAtA[i:], *m.TopicsHash)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TopicsPattern == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_pattern")
+	} else {
+		i -= len(*m.TopicsPattern)
+		copy(dAtA[i:], *m.TopicsPattern)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsPattern)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	} else {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WatcherId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.WatcherId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommandWatchTopicListSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandWatchTopicListSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandWatchTopicListSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TopicsHash == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_hash")
+	} else {
+		i -= len(*m.TopicsHash)
+		copy(dAtA[i:], *m.TopicsHash)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Topic) > 0 {
+		for iNdEx := len(m.Topic) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topic[iNdEx])
+			copy(dAtA[i:], m.Topic[iNdEx])
+			i = encodeVarintPulsarApi(dAtA, i, uint64(len(m.Topic[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WatcherId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.WatcherId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommandWatchTopicUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandWatchTopicUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandWatchTopicUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TopicsHash == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_hash")
+	} else {
+		i -= len(*m.TopicsHash)
+		copy(dAtA[i:], *m.TopicsHash)
+		i = encodeVarintPulsarApi(dAtA, i, uint64(len(*m.TopicsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DeletedTopics) > 0 {
+		for iNdEx := len(m.DeletedTopics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedTopics[iNdEx])
+			copy(dAtA[i:], m.DeletedTopics[iNdEx])
+			i = encodeVarintPulsarApi(dAtA, i, uint64(len(m.DeletedTopics[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NewTopics) > 0 {
+		for iNdEx := len(m.NewTopics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewTopics[iNdEx])
+			copy(dAtA[i:], m.NewTopics[iNdEx])
+			i = encodeVarintPulsarApi(dAtA, i, uint64(len(m.NewTopics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.WatcherId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.WatcherId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommandWatchTopicListClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandWatchTopicListClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandWatchTopicListClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WatcherId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.WatcherId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestId == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	} else {
+		i = encodeVarintPulsarApi(dAtA, i, uint64(*m.RequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommandGetSchema) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11071,7 +11790,63 @@ func (m *BaseCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EndTxnOnSubscriptionResponse != nil {
+	if m.WatchTopicListClose != nil {
+		{
+			size, err := m.WatchTopicListClose.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPulsarApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.WatchTopicUpdate != nil {
+		{
+			size, err := m.WatchTopicUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPulsarApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.WatchTopicListSuccess != nil {
+		{
+			size, err := m.WatchTopicListSuccess.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPulsarApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.WatchTopicList != nil {
+		{
+			size, err := m.WatchTopicList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPulsarApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EndTxnOnSubscriptionResponse != nil {
 		{
 			size, err := m.EndTxnOnSubscriptionResponse.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -12162,6 +12937,12 @@ func (m *FeatureFlags) Size() (n int) {
 	if m.SupportsBrokerEntryMetadata != nil {
 		n += 2
 	}
+	if m.SupportsPartialProducer != nil {
+		n += 2
+	}
+	if m.SupportsTopicWatchers != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12184,6 +12965,10 @@ func (m *CommandConnected) Size() (n int) {
 	if m.MaxMessageSize != nil {
 		n += 1 + sovPulsarApi(uint64(*m.MaxMessageSize))
 	}
+	if m.FeatureFlags != nil {
+		l = m.FeatureFlags.Size()
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13107,6 +13892,14 @@ func (m *CommandGetTopicsOfNamespace) Size() (n int) {
 	if m.Mode != nil {
 		n += 1 + sovPulsarApi(uint64(*m.Mode))
 	}
+	if m.TopicsPattern != nil {
+		l = len(*m.TopicsPattern)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.TopicsHash != nil {
+		l = len(*m.TopicsHash)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13128,6 +13921,123 @@ func (m *CommandGetTopicsOfNamespaceResponse) Size() (n int) {
 			n += 1 + l + sovPulsarApi(uint64(l))
 		}
 	}
+	if m.Filtered != nil {
+		n += 2
+	}
+	if m.TopicsHash != nil {
+		l = len(*m.TopicsHash)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.Changed != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommandWatchTopicList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.RequestId))
+	}
+	if m.WatcherId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.WatcherId))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.TopicsPattern != nil {
+		l = len(*m.TopicsPattern)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.TopicsHash != nil {
+		l = len(*m.TopicsHash)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommandWatchTopicListSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.RequestId))
+	}
+	if m.WatcherId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.WatcherId))
+	}
+	if len(m.Topic) > 0 {
+		for _, s := range m.Topic {
+			l = len(s)
+			n += 1 + l + sovPulsarApi(uint64(l))
+		}
+	}
+	if m.TopicsHash != nil {
+		l = len(*m.TopicsHash)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommandWatchTopicUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WatcherId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.WatcherId))
+	}
+	if len(m.NewTopics) > 0 {
+		for _, s := range m.NewTopics {
+			l = len(s)
+			n += 1 + l + sovPulsarApi(uint64(l))
+		}
+	}
+	if len(m.DeletedTopics) > 0 {
+		for _, s := range m.DeletedTopics {
+			l = len(s)
+			n += 1 + l + sovPulsarApi(uint64(l))
+		}
+	}
+	if m.TopicsHash != nil {
+		l = len(*m.TopicsHash)
+		n += 1 + l + sovPulsarApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommandWatchTopicListClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.RequestId))
+	}
+	if m.WatcherId != nil {
+		n += 1 + sovPulsarApi(uint64(*m.WatcherId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13798,6 +14708,22 @@ func (m *BaseCommand) Size() (n int) {
 		l = m.EndTxnOnSubscriptionResponse.Size()
 		n += 2 + l + sovPulsarApi(uint64(l))
 	}
+	if m.WatchTopicList != nil {
+		l = m.WatchTopicList.Size()
+		n += 2 + l + sovPulsarApi(uint64(l))
+	}
+	if m.WatchTopicListSuccess != nil {
+		l = m.WatchTopicListSuccess.Size()
+		n += 2 + l + sovPulsarApi(uint64(l))
+	}
+	if m.WatchTopicUpdate != nil {
+		l = m.WatchTopicUpdate.Size()
+		n += 2 + l + sovPulsarApi(uint64(l))
+	}
+	if m.WatchTopicListClose != nil {
+		l = m.WatchTopicListClose.Size()
+		n += 2 + l + sovPulsarApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16332,6 +17258,48 @@ func (m *FeatureFlags) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.SupportsBrokerEntryMetadata = &b
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsPartialProducer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SupportsPartialProducer = &b
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsTopicWatchers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SupportsTopicWatchers = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
@@ -16458,6 +17426,42 @@ func (m *CommandConnected) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MaxMessageSize = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeatureFlags == nil {
+				m.FeatureFlags = &FeatureFlags{}
+			}
+			if err := m.FeatureFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
@@ -22001,7 +23005,753 @@ func (m *CommandGetLastMessageIdResponse) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var v uint64
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestId = &v
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerMarkDeletePosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerMarkDeletePosition == nil {
+				m.ConsumerMarkDeletePosition = &MessageIdData{}
+			}
+			if err := m.ConsumerMarkDeletePosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPulsarApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last_message_id")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPulsarApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandGetTopicsOfNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandGetTopicsOfNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestId = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var v CommandGetTopicsOfNamespace_Mode
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= CommandGetTopicsOfNamespace_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mode = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsPattern = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsHash = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPulsarApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPulsarApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandGetTopicsOfNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandGetTopicsOfNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestId = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Filtered = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsHash = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Changed = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPulsarApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommandWatchTopicList) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPulsarApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandWatchTopicList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandWatchTopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestId = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatcherId = &v
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsPattern = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsHash = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPulsarApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_pattern")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommandWatchTopicListSuccess) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPulsarApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandWatchTopicListSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandWatchTopicListSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestId = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherId", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatcherId = &v
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPulsarApi
@@ -22011,18 +23761,29 @@ func (m *CommandGetLastMessageIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequestId = &v
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = append(m.Topic, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerMarkDeletePosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPulsarApi
@@ -22032,28 +23793,26 @@ func (m *CommandGetLastMessageIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPulsarApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPulsarApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerMarkDeletePosition == nil {
-				m.ConsumerMarkDeletePosition = &MessageIdData{}
-			}
-			if err := m.ConsumerMarkDeletePosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsHash = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
@@ -22071,10 +23830,13 @@ func (m *CommandGetLastMessageIdResponse) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last_message_id")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_hash")
 	}
 
 	if iNdEx > l {
@@ -22082,7 +23844,7 @@ func (m *CommandGetLastMessageIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
+func (m *CommandWatchTopicUpdate) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -22106,15 +23868,15 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommandGetTopicsOfNamespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandWatchTopicUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommandGetTopicsOfNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandWatchTopicUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherId", wireType)
 			}
 			var v uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22131,11 +23893,11 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RequestId = &v
+			m.WatcherId = &v
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTopics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22163,15 +23925,13 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.NewTopics = append(m.NewTopics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedTopics", wireType)
 			}
-			var v CommandGetTopicsOfNamespace_Mode
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPulsarApi
@@ -22181,12 +23941,58 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= CommandGetTopicsOfNamespace_Mode(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mode = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedTopics = append(m.DeletedTopics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TopicsHash = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
@@ -22204,10 +24010,10 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("topics_hash")
 	}
 
 	if iNdEx > l {
@@ -22215,7 +24021,7 @@ func (m *CommandGetTopicsOfNamespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
+func (m *CommandWatchTopicListClose) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -22239,10 +24045,10 @@ func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommandGetTopicsOfNamespaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandWatchTopicListClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommandGetTopicsOfNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandWatchTopicListClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22267,10 +24073,10 @@ func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
 			m.RequestId = &v
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherId", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPulsarApi
@@ -22280,24 +24086,13 @@ func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPulsarApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPulsarApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.WatcherId = &v
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
@@ -22317,6 +24112,9 @@ func (m *CommandGetTopicsOfNamespaceResponse) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("request_id")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("watcher_id")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -26943,6 +28741,150 @@ func (m *BaseCommand) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchTopicList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchTopicList == nil {
+				m.WatchTopicList = &CommandWatchTopicList{}
+			}
+			if err := m.WatchTopicList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchTopicListSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchTopicListSuccess == nil {
+				m.WatchTopicListSuccess = &CommandWatchTopicListSuccess{}
+			}
+			if err := m.WatchTopicListSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchTopicUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchTopicUpdate == nil {
+				m.WatchTopicUpdate = &CommandWatchTopicUpdate{}
+			}
+			if err := m.WatchTopicUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchTopicListClose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPulsarApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPulsarApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchTopicListClose == nil {
+				m.WatchTopicListClose = &CommandWatchTopicListClose{}
+			}
+			if err := m.WatchTopicListClose.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPulsarApi(dAtA[iNdEx:])
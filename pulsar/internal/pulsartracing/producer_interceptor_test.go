@@ -59,6 +59,10 @@ func (p *mockProducer) SendAsync(context.Context, *pulsar.ProducerMessage,
 	func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
 }
 
+func (p *mockProducer) SendBatch(context.Context, []*pulsar.ProducerMessage) ([]pulsar.MessageID, error) {
+	return nil, nil
+}
+
 func (p *mockProducer) LastSequenceID() int64 {
 	return 0
 }
@@ -67,4 +71,16 @@ func (p *mockProducer) Flush() error {
 	return nil
 }
 
+func (p *mockProducer) FlushWithContext(context.Context) error {
+	return nil
+}
+
+func (p *mockProducer) FlushAsync(callback func(error)) {
+	callback(nil)
+}
+
+func (p *mockProducer) Stats() pulsar.ProducerStats {
+	return pulsar.ProducerStats{}
+}
+
 func (p *mockProducer) Close() {}
@@ -122,46 +122,49 @@ func (c *rpcClient) Request(logicalAddr *url.URL, physicalAddr *url.URL, request
 		return nil, err
 	}
 
-	ch := make(chan result, 1)
-
-	cnx.SendRequest(requestID, baseCommand(cmdType, message), func(response *pb.BaseCommand, err error) {
-		ch <- result{&RPCResult{
-			Cnx:      cnx,
-			Response: response,
-		}, err}
-		close(ch)
-	})
-
-	select {
-	case res := <-ch:
-		return res.RPCResult, res.error
-	case <-time.After(c.requestTimeout):
-		return nil, ErrRequestTimeOut
-	}
+	return c.requestOnCnx(cnx, requestID, cmdType, message)
 }
 
 func (c *rpcClient) RequestOnCnx(cnx Connection, requestID uint64, cmdType pb.BaseCommand_Type,
 	message proto.Message) (*RPCResult, error) {
 	c.metrics.RPCRequestCount.Inc()
+	return c.requestOnCnx(cnx, requestID, cmdType, message)
+}
 
-	ch := make(chan result, 1)
+func (c *rpcClient) requestOnCnx(cnx Connection, requestID uint64, cmdType pb.BaseCommand_Type,
+	message proto.Message) (*RPCResult, error) {
+	// an intermediate response may come before the final one
+	ch := make(chan result, 2)
 
 	cnx.SendRequest(requestID, baseCommand(cmdType, message), func(response *pb.BaseCommand, err error) {
 		ch <- result{&RPCResult{
 			Cnx:      cnx,
 			Response: response,
 		}, err}
-		close(ch)
 	})
 
-	select {
-	case res := <-ch:
-		return res.RPCResult, res.error
-	case <-time.After(c.requestTimeout):
-		return nil, ErrRequestTimeOut
+	timeout := time.After(c.requestTimeout)
+	for {
+		select {
+		case res := <-ch:
+			if res.error == nil && !isFinalResponse(res.Response) {
+				// the broker acknowledged the request and completes it later, without time limit
+				timeout = nil
+				continue
+			}
+			return res.RPCResult, res.error
+		case <-timeout:
+			return nil, ErrRequestTimeOut
+		}
 	}
 }
 
+// isFinalResponse returns false for the responses followed by another one, such as the success of a producer
+// waiting for the exclusive access to the topic
+func isFinalResponse(response *pb.BaseCommand) bool {
+	return response.GetType() != pb.BaseCommand_PRODUCER_SUCCESS || response.GetProducerSuccess().GetProducerReady()
+}
+
 func (c *rpcClient) RequestOnCnxNoWait(cnx Connection, cmdType pb.BaseCommand_Type, message proto.Message) error {
 	c.metrics.RPCRequestCount.Inc()
 	return cnx.SendRequestNoWait(baseCommand(cmdType, message))
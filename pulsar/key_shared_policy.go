@@ -20,9 +20,17 @@ package pulsar
 import (
 	"fmt"
 
+	"github.com/apache/pulsar-client-go/pulsar/internal"
 	pb "github.com/apache/pulsar-client-go/pulsar/internal/pulsar_proto"
 )
 
+const (
+	// keyHashRangeSize is the size of the hash space the hash ranges are taken from
+	keyHashRangeSize = 65536
+	// noneKey is the sticky key the broker uses for the messages without a key
+	noneKey = "NONE_KEY"
+)
+
 type KeySharedPolicyMode int
 
 const (
@@ -105,3 +113,23 @@ func validateHashRanges(hashRanges []int) error {
 	}
 	return nil
 }
+
+// keyHashInRanges returns true when the sticky key of the message, the ordering key or else the key,
+// hashes into one of the hash ranges given in value pair list: [x1, x2, y1, y2], bounds included.
+func keyHashInRanges(msg Message, hashRanges []int) bool {
+	key := msg.OrderingKey()
+	if key == "" {
+		key = msg.Key()
+	}
+	if key == "" {
+		key = noneKey
+	}
+
+	hash := int(internal.Murmur3_32Hash(key) % keyHashRangeSize)
+	for i := 0; i+1 < len(hashRanges); i += 2 {
+		if hashRanges[i] <= hash && hash <= hashRanges[i+1] {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
 )
 
 func TestNewKeySharedPolicySticky(t *testing.T) {
@@ -89,3 +91,20 @@ func TestValidateHashRanges(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyHashInRanges(t *testing.T) {
+	hash := int(internal.Murmur3_32Hash("my-key") % keyHashRangeSize)
+	noneHash := int(internal.Murmur3_32Hash(noneKey) % keyHashRangeSize)
+
+	assert.True(t, keyHashInRanges(&message{key: "my-key"}, []int{hash, hash}))
+	assert.True(t, keyHashInRanges(&message{key: "my-key"}, []int{0, 0, hash, 65535}))
+	if hash > 0 {
+		assert.False(t, keyHashInRanges(&message{key: "my-key"}, []int{0, hash - 1}))
+	}
+
+	// the ordering key takes precedence over the key
+	assert.True(t, keyHashInRanges(&message{key: "other-key", orderingKey: "my-key"}, []int{hash, hash}))
+
+	// the messages without key are hashed as the broker does
+	assert.True(t, keyHashInRanges(&message{}, []int{noneHash, noneHash}))
+}
@@ -122,6 +122,13 @@ type Message interface {
 	GetEncryptionContext() *EncryptionContext
 }
 
+// SchemaVersionMessage is implemented by the messages received by the consumers and the readers, it is kept out
+// of Message so that the implementations of Message outside of the library do not break
+type SchemaVersionMessage interface {
+	// SchemaVersion get the version of the schema the message was produced with, if any
+	SchemaVersion() []byte
+}
+
 // MessageID identifier for a particular message
 type MessageID interface {
 	// Serialize the message id into a sequence of bytes that can be stored somewhere else
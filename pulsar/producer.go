@@ -53,6 +53,22 @@ const (
 	Better
 )
 
+// ProducerAccessMode defines how the producer shares the topic with the other producers
+type ProducerAccessMode int
+
+const (
+	// ProducerAccessModeShared lets several producers publish on the topic
+	ProducerAccessModeShared ProducerAccessMode = iota
+
+	// ProducerAccessModeExclusive requires the exclusive access to the topic, the producer creation fails
+	// with ProducerBusy if the topic already has a producer
+	ProducerAccessModeExclusive
+
+	// ProducerAccessModeWaitForExclusive blocks the producer creation until the producer is granted the
+	// exclusive access to the topic
+	ProducerAccessModeWaitForExclusive
+)
+
 // TopicMetadata is a interface of topic metadata
 type TopicMetadata interface {
 	// NumPartitions get the number of partitions for the specific topic
@@ -150,6 +166,15 @@ type ProducerOptions struct {
 
 	Schema Schema
 
+	// ProducerAccessMode sets the access mode of the producer to the topic. (default: ProducerAccessModeShared)
+	// The producers losing the exclusive access, when the topic is fenced, fail with ProducerFenced.
+	ProducerAccessMode ProducerAccessMode
+
+	// ValidatePayloads checks the payloads of the JSON and Avro schemas against the definition of the Schema before
+	// sending them, the messages whose payload does not match the schema fail with InvalidMessage and never reach
+	// the topic. (default: false)
+	ValidatePayloads bool
+
 	// MaxReconnectToBroker set the maximum retry number of reconnectToBroker. (default: ultimate)
 	MaxReconnectToBroker *uint
 
@@ -166,6 +191,11 @@ type ProducerOptions struct {
 
 	// Encryption necessary fields to perform encryption of message
 	Encryption *ProducerEncryptionInfo
+
+	// LazyStartPartitionedProducers creates the producer of a partition the first time the MessageRouter picks it,
+	// instead of connecting all the partitions with the producer. The first partition is always started. A failed
+	// creation is reported to the send callback and retried by the next message. (default: false)
+	LazyStartPartitionedProducers bool
 }
 
 // Producer is used to publish messages on a topic
@@ -189,6 +219,12 @@ type Producer interface {
 	// the eventual error in publishing
 	SendAsync(context.Context, *ProducerMessage, func(MessageID, *ProducerMessage, error))
 
+	// SendBatch sends messages in a single batch, persisted in a single entry by the broker, and returns their
+	// message ids in the same order. The messages are published on the partition the MessageRouter picks for the
+	// first one, either all of them or none: the call fails if they do not fit in a batch.
+	// This call will be blocking until the batch is successfully acknowledged by the Pulsar broker.
+	SendBatch(context.Context, []*ProducerMessage) ([]MessageID, error)
+
 	// LastSequenceID get the last sequence id that was published by this producer.
 	// This represent either the automatically assigned or custom sequence id (set on the ProducerMessage) that
 	// was published and acknowledged by the broker.
@@ -201,6 +237,18 @@ type Producer interface {
 	// persisted.
 	Flush() error
 
+	// FlushWithContext flushes all the messages buffered in the client like Flush, but gives up waiting when the
+	// context is done and returns its error. The messages are still sent after the context is done.
+	FlushWithContext(ctx context.Context) error
+
+	// FlushAsync flushes all the messages buffered in the client without waiting. The callback is called, with the
+	// first error if any, once all the messages enqueued before the call have been persisted. The callback may be
+	// nil when the result of the flush is not needed.
+	FlushAsync(callback func(error))
+
+	// Stats returns a snapshot of the statistics of the producer, merged over its partitions
+	Stats() ProducerStats
+
 	// Close the producer and releases resources allocated
 	// No more writes will be accepted from this producer. Waits until all pending write request are persisted. In case
 	// of errors, pending writes will not be retried.
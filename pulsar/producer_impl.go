@@ -216,6 +216,15 @@ func (p *producer) internalCreatePartitionsProducers() error {
 	for partitionIdx := startPartition; partitionIdx < newNumPartitions; partitionIdx++ {
 		partition := partitions[partitionIdx]
 
+		if p.options.LazyStartPartitionedProducers && partitionIdx > 0 {
+			// the first partition is always started, to check the options of the producer
+			c <- ProducerError{
+				partition: partitionIdx,
+				prod:      newLazyPartitionProducer(p.client, partition, p.options, partitionIdx, p.metrics),
+			}
+			continue
+		}
+
 		go func(partitionIdx int, partition string) {
 			prod, e := newPartitionProducer(p.client, partition, p.options, partitionIdx, p.metrics)
 			c <- ProducerError{
@@ -273,14 +282,50 @@ func (p *producer) NumPartitions() uint32 {
 }
 
 func (p *producer) Send(ctx context.Context, msg *ProducerMessage) (MessageID, error) {
+	if err := p.setSchemaKey(msg); err != nil {
+		return nil, err
+	}
 	return p.getPartition(msg).Send(ctx, msg)
 }
 
 func (p *producer) SendAsync(ctx context.Context, msg *ProducerMessage,
 	callback func(MessageID, *ProducerMessage, error)) {
+	if err := p.setSchemaKey(msg); err != nil {
+		callback(nil, msg, err)
+		return
+	}
 	p.getPartition(msg).SendAsync(ctx, msg, callback)
 }
 
+func (p *producer) SendBatch(ctx context.Context, msgs []*ProducerMessage) ([]MessageID, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	for _, msg := range msgs {
+		if err := p.setSchemaKey(msg); err != nil {
+			return nil, err
+		}
+	}
+	return p.getPartition(msgs[0]).SendBatch(ctx, msgs)
+}
+
+// setSchemaKey sets the message key to the encoded key of the KeyValue schemas with the SEPARATED encoding,
+// before the message is routed to a partition
+func (p *producer) setSchemaKey(msg *ProducerMessage) error {
+	kvs, ok := p.options.Schema.(*KeyValueSchema)
+	if !ok || kvs.encoding != KeyValueEncodingSeparated || msg.Value == nil {
+		return nil
+	}
+
+	key, err := kvs.encodeKey(msg.Value)
+	if err != nil {
+		p.log.WithError(err).Errorf("Schema encode message key failed %s", msg.Value)
+		return err
+	}
+	msg.Key = key
+	return nil
+}
+
 func (p *producer) getPartition(msg *ProducerMessage) Producer {
 	// Since partitions can only increase, it's ok if the producers list
 	// is updated in between. The numPartition is updated only after the list.
@@ -308,12 +353,32 @@ func (p *producer) LastSequenceID() int64 {
 	return maxSeq
 }
 
+func (p *producer) Stats() ProducerStats {
+	p.RLock()
+	defer p.RUnlock()
+
+	stats := newProducerStats()
+	var latencies []time.Duration
+	for _, pp := range p.producers {
+		if source, ok := pp.(producerStatsSource); ok {
+			stats.merge(source.Stats())
+			latencies = append(latencies, source.publishLatencies()...)
+		}
+	}
+	stats.PublishLatency = newPublishLatencyStats(latencies)
+	return stats
+}
+
 func (p *producer) Flush() error {
+	return p.FlushWithContext(context.Background())
+}
+
+func (p *producer) FlushWithContext(ctx context.Context) error {
 	p.RLock()
 	defer p.RUnlock()
 
 	for _, pp := range p.producers {
-		if err := pp.Flush(); err != nil {
+		if err := pp.FlushWithContext(ctx); err != nil {
 			return err
 		}
 
@@ -321,6 +386,38 @@ func (p *producer) Flush() error {
 	return nil
 }
 
+func (p *producer) FlushAsync(callback func(error)) {
+	if callback == nil {
+		callback = func(error) {}
+	}
+
+	p.RLock()
+	producers := p.producers
+	p.RUnlock()
+
+	if len(producers) == 0 {
+		callback(nil)
+		return
+	}
+
+	var lock sync.Mutex
+	var flushErr error
+	remaining := int32(len(producers))
+	for _, pp := range producers {
+		pp.FlushAsync(func(err error) {
+			lock.Lock()
+			if err != nil && flushErr == nil {
+				flushErr = err
+			}
+			lock.Unlock()
+
+			if atomic.AddInt32(&remaining, -1) == 0 {
+				callback(flushErr)
+			}
+		})
+	}
+}
+
 func (p *producer) Close() {
 	p.closeOnce.Do(func() {
 		p.stopDiscovery()
@@ -40,4 +40,38 @@ func (x ProducerInterceptors) OnSendAcknowledgement(producer Producer, message *
 	}
 }
 
+// CheckSend runs every RejectingProducerInterceptor in the chain, in order, and returns the first error.
+func (x ProducerInterceptors) CheckSend(producer Producer, message *ProducerMessage) error {
+	for i := range x {
+		if r, ok := x[i].(RejectingProducerInterceptor); ok {
+			if err := r.CheckSend(producer, message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (x ProducerInterceptors) OnSendError(producer Producer, message *ProducerMessage, err error) {
+	for i := range x {
+		if r, ok := x[i].(RejectingProducerInterceptor); ok {
+			r.OnSendError(producer, message, err)
+		}
+	}
+}
+
 var defaultProducerInterceptors = make(ProducerInterceptors, 0)
+
+// RejectingProducerInterceptor is a ProducerInterceptor that can also refuse to send messages and that is told
+// about failed sends. Interceptors in ProducerOptions.Interceptors that implement it are detected automatically.
+type RejectingProducerInterceptor interface {
+	ProducerInterceptor
+
+	// CheckSend This is called after BeforeSend. Returning a non-nil error aborts the send: the message is not
+	// published and the send fails with an *Error whose Result is MessageRejected.
+	CheckSend(producer Producer, message *ProducerMessage) error
+
+	// OnSendError This method is called when sending the message fails, including when the message was rejected
+	// by CheckSend. Successful sends are reported to OnSendAcknowledgement.
+	OnSendError(producer Producer, message *ProducerMessage, err error)
+}
@@ -0,0 +1,281 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+)
+
+// lazyPartitionProducer creates the producer of a partition the first time a message is routed to it
+type lazyPartitionProducer struct {
+	sync.Mutex
+	client       *client
+	topic        string
+	options      *ProducerOptions
+	partitionIdx int
+	metrics      *internal.LeveledMetrics
+
+	producer *partitionProducer
+	creation *producerCreation
+	closed   bool
+}
+
+// producerCreation is a running creation of the producer of a partition
+type producerCreation struct {
+	// done is closed once the creation completed and the pending sends were made
+	done chan struct{}
+	err  error
+	// pending are the asynchronous sends waiting for the producer, in the order of the calls
+	pending []func(*partitionProducer, error)
+}
+
+func newLazyPartitionProducer(client *client, topic string, options *ProducerOptions, partitionIdx int,
+	metrics *internal.LeveledMetrics) *lazyPartitionProducer {
+	return &lazyPartitionProducer{
+		client:       client,
+		topic:        topic,
+		options:      options,
+		partitionIdx: partitionIdx,
+		metrics:      metrics,
+	}
+}
+
+// getOrCreate returns the producer of the partition, waiting for its creation if needed. A failed creation is
+// retried by the next send.
+func (p *lazyPartitionProducer) getOrCreate() (*partitionProducer, error) {
+	p.Lock()
+	if p.closed {
+		p.Unlock()
+		return nil, errProducerClosed
+	}
+	if pp := p.producer; pp != nil {
+		p.Unlock()
+		return pp, nil
+	}
+	c := p.start()
+	p.Unlock()
+
+	<-c.done
+	if c.err != nil {
+		return nil, c.err
+	}
+	return p.started(), nil
+}
+
+// start starts the creation of the producer of the partition if it is not running, it must be called with the
+// lock held
+func (p *lazyPartitionProducer) start() *producerCreation {
+	if p.creation == nil {
+		p.creation = &producerCreation{done: make(chan struct{})}
+		go p.create(p.creation)
+	}
+	return p.creation
+}
+
+// create creates the producer of the partition without holding the lock, then makes the pending sends
+func (p *lazyPartitionProducer) create(c *producerCreation) {
+	prod, err := newPartitionProducer(p.client, p.topic, p.options, p.partitionIdx, p.metrics)
+
+	for {
+		p.Lock()
+		if err == nil && p.closed {
+			prod.Close()
+			prod, err = nil, errProducerClosed
+		}
+
+		pending := c.pending
+		c.pending = nil
+		if len(pending) == 0 {
+			c.err = err
+			if err == nil {
+				p.producer = prod
+			} else {
+				// the next send retries the creation
+				p.creation = nil
+			}
+			p.Unlock()
+			close(c.done)
+			return
+		}
+		p.Unlock()
+
+		for _, send := range pending {
+			send(prod, err)
+		}
+	}
+}
+
+// started returns the producer of the partition, or nil if it was not created yet
+func (p *lazyPartitionProducer) started() *partitionProducer {
+	p.Lock()
+	defer p.Unlock()
+	return p.producer
+}
+
+// creating returns the running creation of the producer of the partition, or nil if the producer is created or
+// no creation is running
+func (p *lazyPartitionProducer) creating() *producerCreation {
+	p.Lock()
+	defer p.Unlock()
+	if p.producer != nil {
+		return nil
+	}
+	return p.creation
+}
+
+func (p *lazyPartitionProducer) Topic() string {
+	return p.topic
+}
+
+func (p *lazyPartitionProducer) Name() string {
+	if pp := p.started(); pp != nil {
+		return pp.Name()
+	}
+	return ""
+}
+
+func (p *lazyPartitionProducer) Send(ctx context.Context, msg *ProducerMessage) (MessageID, error) {
+	pp, err := p.getOrCreate()
+	if err != nil {
+		return nil, err
+	}
+	return pp.Send(ctx, msg)
+}
+
+func (p *lazyPartitionProducer) SendAsync(ctx context.Context, msg *ProducerMessage,
+	callback func(MessageID, *ProducerMessage, error)) {
+	p.Lock()
+	if p.closed {
+		p.Unlock()
+		callback(nil, msg, errProducerClosed)
+		return
+	}
+	if pp := p.producer; pp != nil {
+		p.Unlock()
+		pp.SendAsync(ctx, msg, callback)
+		return
+	}
+
+	// the message is sent once the producer is created, a creation error is reported to the callback
+	c := p.start()
+	c.pending = append(c.pending, func(pp *partitionProducer, err error) {
+		if err != nil {
+			callback(nil, msg, err)
+			return
+		}
+		pp.SendAsync(ctx, msg, callback)
+	})
+	p.Unlock()
+}
+
+func (p *lazyPartitionProducer) SendBatch(ctx context.Context, msgs []*ProducerMessage) ([]MessageID, error) {
+	pp, err := p.getOrCreate()
+	if err != nil {
+		return nil, err
+	}
+	return pp.SendBatch(ctx, msgs)
+}
+
+func (p *lazyPartitionProducer) LastSequenceID() int64 {
+	if pp := p.started(); pp != nil {
+		return pp.LastSequenceID()
+	}
+	return -1
+}
+
+func (p *lazyPartitionProducer) Flush() error {
+	return p.FlushWithContext(context.Background())
+}
+
+func (p *lazyPartitionProducer) FlushWithContext(ctx context.Context) error {
+	// the messages sent while the producer is being created are flushed too
+	if c := p.creating(); c != nil {
+		select {
+		case <-c.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if c.err != nil {
+			return c.err
+		}
+	}
+
+	if pp := p.started(); pp != nil {
+		return pp.FlushWithContext(ctx)
+	}
+	return nil
+}
+
+func (p *lazyPartitionProducer) FlushAsync(callback func(error)) {
+	if callback == nil {
+		callback = func(error) {}
+	}
+
+	// the messages sent while the producer is being created are flushed too
+	if c := p.creating(); c != nil {
+		go func() {
+			<-c.done
+			if c.err != nil {
+				callback(c.err)
+				return
+			}
+			p.started().FlushAsync(callback)
+		}()
+		return
+	}
+
+	if pp := p.started(); pp != nil {
+		pp.FlushAsync(callback)
+		return
+	}
+	callback(nil)
+}
+
+func (p *lazyPartitionProducer) Stats() ProducerStats {
+	if pp := p.started(); pp != nil {
+		return pp.Stats()
+	}
+	return newProducerStats()
+}
+
+func (p *lazyPartitionProducer) publishLatencies() []time.Duration {
+	if pp := p.started(); pp != nil {
+		return pp.publishLatencies()
+	}
+	return nil
+}
+
+func (p *lazyPartitionProducer) Close() {
+	p.Lock()
+	p.closed = true
+	pp, c := p.producer, p.creation
+	p.Unlock()
+
+	if pp == nil && c != nil {
+		// the running creation closes the producer and fails the pending sends
+		<-c.done
+		return
+	}
+	if pp != nil {
+		pp.Close()
+	}
+}
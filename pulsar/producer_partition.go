@@ -46,6 +46,7 @@ const (
 	producerReady
 	producerClosing
 	producerClosed
+	producerFenced
 )
 
 var (
@@ -55,6 +56,12 @@ var (
 	errContextExpired  = newError(TimeoutError, "message send context expired")
 	errMessageTooLarge = newError(MessageTooBig, "message size exceeds MaxMessageSize")
 	errProducerClosed  = newError(ProducerClosed, "producer already been closed")
+	errProducerFenced  = newError(ProducerFenced, "producer fenced by a producer with exclusive access to the topic")
+
+	errMemoryBufferIsFull = newError(ProducerQueueIsFull, "client memory buffer is full")
+	errBatchTooLarge      = newError(AddToBatchFailed, "messages do not fit in a single batch")
+	errBatchNotSupported  = newError(InvalidMessage,
+		"delayed and replication-controlled messages can not be sent in a batch")
 
 	buffersPool sync.Pool
 )
@@ -79,15 +86,38 @@ type partitionProducer struct {
 	// Channel where app is posting messages to be published
 	eventsChan      chan interface{}
 	connectClosedCh chan connectionClosed
+	// closedCh is closed once the events loop stopped, after failing the requests left in eventsChan
+	closedCh chan struct{}
 
 	publishSemaphore internal.Semaphore
 	pendingQueue     internal.BlockingQueue
 	lastSequenceID   int64
 	schemaInfo       *SchemaInfo
+	// schemaVersion is the version of the schema of the producer, returned by the broker
+	schemaVersion []byte
+	// payloadValidator checks the payloads of the AUTO_PUBLISH producers against the schema of the topic
+	payloadValidator *payloadValidator
 	partitionIdx     int32
 	metrics          *internal.LeveledMetrics
 
 	epoch uint64
+	// topicEpoch is the epoch of the topic returned by the broker to the exclusive producers
+	topicEpoch *uint64
+
+	// maxPending is the number of permits of the publishSemaphore
+	maxPending int
+	// encryptor encrypts the batches built outside of the batch builder of the producer
+	encryptor internalcrypto.Encryptor
+
+	// statistics returned by Stats
+	messagesSent    int64
+	bytesSent       int64
+	pendingMessages int64
+	batchMessages   int64
+	batchBytes      int64
+	sendErrorsLock  sync.Mutex
+	sendErrors      map[Result]int64
+	latencies       *latencyWindow
 }
 
 func newPartitionProducer(client *client, topic string, options *ProducerOptions, partitionIdx int,
@@ -117,6 +147,7 @@ func newPartitionProducer(client *client, topic string, options *ProducerOptions
 		producerID:       client.rpcClient.NewProducerID(),
 		eventsChan:       make(chan interface{}, maxPendingMessages),
 		connectClosedCh:  make(chan connectionClosed, 10),
+		closedCh:         make(chan struct{}),
 		batchFlushTicker: time.NewTicker(batchingMaxPublishDelay),
 		publishSemaphore: internal.NewSemaphore(int32(maxPendingMessages)),
 		pendingQueue:     internal.NewBlockingQueue(maxPendingMessages),
@@ -124,6 +155,9 @@ func newPartitionProducer(client *client, topic string, options *ProducerOptions
 		partitionIdx:     int32(partitionIdx),
 		metrics:          metrics,
 		epoch:            0,
+		maxPending:       maxPendingMessages,
+		sendErrors:       make(map[Result]int64),
+		latencies:        newLatencyWindow(),
 	}
 	p.setProducerState(producerInit)
 
@@ -191,6 +225,13 @@ func (p *partitionProducer) grabCnx() error {
 	p.log.Debug("Lookup result: ", lr)
 	id := p.client.rpcClient.NewRequestID()
 
+	if _, ok := p.options.Schema.(*AutoPublishSchema); ok {
+		if err := p.fetchTopicSchema(); err != nil {
+			p.log.WithError(err).Warn("Failed to fetch the schema of the topic")
+			return err
+		}
+	}
+
 	// set schema info for producer
 
 	pbSchema := new(pb.Schema)
@@ -216,6 +257,8 @@ func (p *partitionProducer) grabCnx() error {
 		Schema:                   pbSchema,
 		Epoch:                    proto.Uint64(atomic.LoadUint64(&p.epoch)),
 		UserProvidedProducerName: proto.Bool(p.userProvidedProducerName),
+		ProducerAccessMode:       toProtoProducerAccessMode(p.options.ProducerAccessMode).Enum(),
+		TopicEpoch:               p.topicEpoch,
 	}
 
 	if p.producerName != "" {
@@ -228,10 +271,15 @@ func (p *partitionProducer) grabCnx() error {
 	res, err := p.client.rpcClient.Request(lr.LogicalAddr, lr.PhysicalAddr, id, pb.BaseCommand_PRODUCER, cmdProducer)
 	if err != nil {
 		p.log.WithError(err).Error("Failed to create producer")
-		return err
+		return toProducerError(err)
 	}
 
 	p.producerName = res.Response.ProducerSuccess.GetProducerName()
+	if res.Response.ProducerSuccess.TopicEpoch != nil {
+		topicEpoch := res.Response.ProducerSuccess.GetTopicEpoch()
+		p.topicEpoch = &topicEpoch
+	}
+	p.schemaVersion = res.Response.ProducerSuccess.GetSchemaVersion()
 
 	var encryptor internalcrypto.Encryptor
 	if p.options.Encryption != nil {
@@ -242,6 +290,7 @@ func (p *partitionProducer) grabCnx() error {
 	} else {
 		encryptor = internalcrypto.NewNoopEncryptor()
 	}
+	p.encryptor = encryptor
 
 	if p.options.DisableBatching {
 		provider, _ := GetBatcherBuilderProvider(DefaultBatchBuilder)
@@ -327,6 +376,34 @@ func (p *partitionProducer) ConnectionClosed() {
 	p.connectClosedCh <- connectionClosed{}
 }
 
+func toProtoProducerAccessMode(accessMode ProducerAccessMode) pb.ProducerAccessMode {
+	switch accessMode {
+	case ProducerAccessModeExclusive:
+		return pb.ProducerAccessMode_Exclusive
+	case ProducerAccessModeWaitForExclusive:
+		return pb.ProducerAccessMode_WaitForExclusive
+	default:
+		return pb.ProducerAccessMode_Shared
+	}
+}
+
+// toProducerError maps the errors of the broker refusing the producer to their Result
+func toProducerError(err error) error {
+	serverErr, ok := err.(*internal.ServerError)
+	if !ok {
+		return err
+	}
+
+	switch serverErr.Code {
+	case pb.ServerError_ProducerBusy:
+		return newError(ProducerBusy, serverErr.Error())
+	case pb.ServerError_ProducerFenced:
+		return newError(ProducerFenced, serverErr.Error())
+	default:
+		return err
+	}
+}
+
 func (p *partitionProducer) reconnectToBroker() {
 	var (
 		maxRetry int
@@ -361,6 +438,13 @@ func (p *partitionProducer) reconnectToBroker() {
 			p.log.Warn("Topic Not Found.")
 			break
 		}
+		if pe, ok := err.(*Error); ok && pe.Result() == ProducerFenced {
+			// another producer took the exclusive access to the topic, the producer can not send anymore
+			p.log.Warn("Producer fenced.")
+			p.setProducerState(producerFenced)
+			p.failPendingMessages(errProducerFenced)
+			break
+		}
 
 		if maxRetry > 0 {
 			maxRetry--
@@ -375,6 +459,8 @@ func (p *partitionProducer) runEventsLoop() {
 			switch v := i.(type) {
 			case *sendRequest:
 				p.internalSend(v)
+			case *sendBatchRequest:
+				p.internalSendBatch(v)
 			case *flushRequest:
 				p.internalFlush(v)
 			case *closeProducer:
@@ -404,32 +490,17 @@ func (p *partitionProducer) Name() string {
 func (p *partitionProducer) internalSend(request *sendRequest) {
 	p.log.Debug("Received send request: ", *request)
 
-	msg := request.msg
-
-	payload := msg.Payload
-	var schemaPayload []byte
-	var err error
-	if p.options.Schema != nil {
-		schemaPayload, err = p.options.Schema.Encode(msg.Value)
-		if err != nil {
-			p.log.WithError(err).Errorf("Schema encode message failed %s", msg.Value)
-			return
-		}
+	if p.getProducerState() == producerFenced {
+		p.failSendRequests([]interface{}{request}, errProducerFenced)
+		return
 	}
 
-	if payload == nil {
-		payload = schemaPayload
-	}
+	msg := request.msg
 
-	// if msg is too large
-	if len(payload) > int(p.cnx.GetMaxMessageSize()) {
-		p.publishSemaphore.Release()
-		request.callback(nil, request.msg, errMessageTooLarge)
-		p.log.WithError(errMessageTooLarge).
-			WithField("size", len(payload)).
-			WithField("properties", msg.Properties).
-			Errorf("MaxMessageSize %d", int(p.cnx.GetMaxMessageSize()))
-		p.metrics.PublishErrorsMsgTooLarge.Inc()
+	payload, err := p.encodePayload(request)
+	if err != nil {
+		p.releaseSemaphoreAndMem(request.reservedMem)
+		request.callback(nil, request.msg, err)
 		return
 	}
 
@@ -442,30 +513,7 @@ func (p *partitionProducer) internalSend(request *sendRequest) {
 		msg.ReplicationClusters == nil &&
 		deliverAt.UnixNano() < 0
 
-	smm := &pb.SingleMessageMetadata{
-		PayloadSize: proto.Int(len(payload)),
-	}
-
-	if msg.EventTime.UnixNano() != 0 {
-		smm.EventTime = proto.Uint64(internal.TimestampMillis(msg.EventTime))
-	}
-
-	if msg.Key != "" {
-		smm.PartitionKey = proto.String(msg.Key)
-	}
-
-	if len(msg.OrderingKey) != 0 {
-		smm.OrderingKey = []byte(msg.OrderingKey)
-	}
-
-	if msg.Properties != nil {
-		smm.Properties = internal.ConvertFromStringMap(msg.Properties)
-	}
-
-	if msg.SequenceID != nil {
-		sequenceID := uint64(*msg.SequenceID)
-		smm.SequenceId = proto.Uint64(sequenceID)
-	}
+	smm := p.singleMessageMetadata(msg, payload)
 
 	if !sendAsBatch {
 		p.internalFlushCurrentBatch()
@@ -476,8 +524,10 @@ func (p *partitionProducer) internalSend(request *sendRequest) {
 	}
 
 	added := p.batchBuilder.Add(smm, p.sequenceIDGenerator, payload, request,
-		msg.ReplicationClusters, deliverAt)
-	if !added {
+		msg.ReplicationClusters, deliverAt, p.schemaVersion)
+	if added {
+		p.addToBatchStats(len(payload))
+	} else {
 		// The current batch is full.. flush it and retry
 		if p.batchBuilder.IsMultiBatches() {
 			p.internalFlushCurrentBatches()
@@ -487,14 +537,15 @@ func (p *partitionProducer) internalSend(request *sendRequest) {
 
 		// after flushing try again to add the current payload
 		if ok := p.batchBuilder.Add(smm, p.sequenceIDGenerator, payload, request,
-			msg.ReplicationClusters, deliverAt); !ok {
-			p.publishSemaphore.Release()
+			msg.ReplicationClusters, deliverAt, p.schemaVersion); !ok {
+			p.releaseSemaphoreAndMem(request.reservedMem)
 			request.callback(nil, request.msg, errFailAddToBatch)
 			p.log.WithField("size", len(payload)).
 				WithField("properties", msg.Properties).
 				Error("unable to add message to batch")
 			return
 		}
+		p.addToBatchStats(len(payload))
 	}
 
 	if !sendAsBatch || request.flushImmediately {
@@ -506,6 +557,114 @@ func (p *partitionProducer) internalSend(request *sendRequest) {
 	}
 }
 
+// encodeValue encodes the value of the message of a request with the schema of the producer, before the memory
+// of the payload is reserved
+func (p *partitionProducer) encodeValue(request *sendRequest) error {
+	if p.options.Schema == nil {
+		return nil
+	}
+
+	schemaPayload, err := p.options.Schema.Encode(request.msg.Value)
+	if err != nil {
+		p.log.WithError(err).Errorf("Schema encode message failed %s", request.msg.Value)
+		return err
+	}
+	request.schemaPayload = schemaPayload
+	return nil
+}
+
+// encodePayload returns the payload of the message of a request, after checking it against the schema and the
+// max message size
+func (p *partitionProducer) encodePayload(request *sendRequest) ([]byte, error) {
+	msg := request.msg
+	payload := msg.Payload
+	if payload == nil {
+		payload = request.schemaPayload
+	}
+
+	if schema, ok := p.options.Schema.(definitionValidator); ok && p.options.ValidatePayloads {
+		if err := schema.Validate(payload); err != nil {
+			p.log.WithError(err).Errorf("Schema validation of message failed")
+			return nil, err
+		}
+	}
+
+	if p.payloadValidator != nil {
+		if err := p.payloadValidator.validate(payload); err != nil {
+			p.log.WithError(err).Errorf("Schema validation of message failed")
+			return nil, err
+		}
+	}
+
+	// if msg is too large
+	if len(payload) > int(p.cnx.GetMaxMessageSize()) {
+		p.log.WithError(errMessageTooLarge).
+			WithField("size", len(payload)).
+			WithField("properties", msg.Properties).
+			Errorf("MaxMessageSize %d", int(p.cnx.GetMaxMessageSize()))
+		p.metrics.PublishErrorsMsgTooLarge.Inc()
+		return nil, errMessageTooLarge
+	}
+
+	return payload, nil
+}
+
+// singleMessageMetadata returns the metadata of a message in a batch
+func (p *partitionProducer) singleMessageMetadata(msg *ProducerMessage, payload []byte) *pb.SingleMessageMetadata {
+	smm := &pb.SingleMessageMetadata{
+		PayloadSize: proto.Int(len(payload)),
+	}
+
+	if msg.EventTime.UnixNano() != 0 {
+		smm.EventTime = proto.Uint64(internal.TimestampMillis(msg.EventTime))
+	}
+
+	if msg.Key != "" {
+		smm.PartitionKey = proto.String(msg.Key)
+		if kvs, ok := p.options.Schema.(*KeyValueSchema); ok && kvs.encoding == KeyValueEncodingSeparated {
+			smm.PartitionKeyB64Encoded = proto.Bool(true)
+		}
+	}
+
+	if len(msg.OrderingKey) != 0 {
+		smm.OrderingKey = []byte(msg.OrderingKey)
+	}
+
+	if msg.Properties != nil {
+		smm.Properties = internal.ConvertFromStringMap(msg.Properties)
+	}
+
+	if msg.SequenceID != nil {
+		sequenceID := uint64(*msg.SequenceID)
+		smm.SequenceId = proto.Uint64(sequenceID)
+	}
+
+	return smm
+}
+
+// fetchTopicSchema sets the schema of the producer to the schema of the topic, for AUTO_PUBLISH producers
+func (p *partitionProducer) fetchTopicSchema() error {
+	schema, err := p.client.lookupService.GetSchema(p.topic, nil)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		// the payloads are published as bytes
+		p.schemaInfo = nil
+		p.payloadValidator = nil
+		return nil
+	}
+
+	info := fromProtoSchema(schema)
+	validator, err := newPayloadValidator(info)
+	if err != nil {
+		return err
+	}
+	p.schemaInfo = info
+	p.payloadValidator = validator
+	return nil
+}
+
 type pendingItem struct {
 	sync.Mutex
 	batchData    internal.Buffer
@@ -516,6 +675,7 @@ type pendingItem struct {
 }
 
 func (p *partitionProducer) internalFlushCurrentBatch() {
+	p.resetBatchStats()
 	batchData, sequenceID, callbacks, err := p.batchBuilder.Flush()
 	if batchData == nil {
 		return
@@ -526,6 +686,7 @@ func (p *partitionProducer) internalFlushCurrentBatch() {
 	if err != nil {
 		for _, cb := range callbacks {
 			if sr, ok := cb.(*sendRequest); ok {
+				p.releaseSemaphoreAndMem(sr.reservedMem)
 				sr.callback(nil, sr.msg, err)
 			}
 		}
@@ -551,7 +712,7 @@ func (p *partitionProducer) failTimeoutMessages() {
 
 	for range t.C {
 		state := p.getProducerState()
-		if state == producerClosing || state == producerClosed {
+		if state == producerClosing || state == producerClosed || state == producerFenced {
 			return
 		}
 
@@ -619,7 +780,7 @@ func (p *partitionProducer) failTimeoutMessages() {
 				sr := i.(*sendRequest)
 				if sr.msg != nil {
 					size := len(sr.msg.Payload)
-					p.publishSemaphore.Release()
+					p.releaseSemaphoreAndMem(sr.reservedMem)
 					p.metrics.MessagesPending.Dec()
 					p.metrics.BytesPending.Sub(float64(size))
 					p.metrics.PublishErrorsTimeout.Inc()
@@ -646,6 +807,7 @@ func (p *partitionProducer) failTimeoutMessages() {
 }
 
 func (p *partitionProducer) internalFlushCurrentBatches() {
+	p.resetBatchStats()
 	batchesData, sequenceIDs, callbacks, errors := p.batchBuilder.FlushBatches()
 	if batchesData == nil {
 		return
@@ -657,6 +819,7 @@ func (p *partitionProducer) internalFlushCurrentBatches() {
 		if errors[i] != nil {
 			for _, cb := range callbacks[i] {
 				if sr, ok := cb.(*sendRequest); ok {
+					p.releaseSemaphoreAndMem(sr.reservedMem)
 					sr.callback(nil, sr.msg, errors[i])
 				}
 			}
@@ -677,6 +840,11 @@ func (p *partitionProducer) internalFlushCurrentBatches() {
 }
 
 func (p *partitionProducer) internalFlush(fr *flushRequest) {
+	if p.getProducerState() == producerFenced {
+		fr.callback(errProducerFenced)
+		return
+	}
+
 	if p.batchBuilder.IsMultiBatches() {
 		p.internalFlushCurrentBatches()
 	} else {
@@ -685,7 +853,7 @@ func (p *partitionProducer) internalFlush(fr *flushRequest) {
 
 	pi, ok := p.pendingQueue.PeekLast().(*pendingItem)
 	if !ok {
-		fr.waitGroup.Done()
+		fr.callback(nil)
 		return
 	}
 
@@ -698,15 +866,14 @@ func (p *partitionProducer) internalFlush(fr *flushRequest) {
 		// The last item in the queue has been completed while we were
 		// looking at it. It's safe at this point to assume that every
 		// message enqueued before Flush() was called are now persisted
-		fr.waitGroup.Done()
+		fr.callback(nil)
 		return
 	}
 
 	sendReq := &sendRequest{
 		msg: nil,
 		callback: func(id MessageID, message *ProducerMessage, e error) {
-			fr.err = e
-			fr.waitGroup.Done()
+			fr.callback(e)
 		},
 	}
 
@@ -730,6 +897,197 @@ func (p *partitionProducer) Send(ctx context.Context, msg *ProducerMessage) (Mes
 	return msgID, err
 }
 
+func (p *partitionProducer) SendBatch(ctx context.Context, msgs []*ProducerMessage) ([]MessageID, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(msgs))
+
+	var lock sync.Mutex
+	var err error
+	msgIDs := make([]MessageID, len(msgs))
+
+	callbacks := make([]func(MessageID, *ProducerMessage, error), len(msgs))
+	for i := range msgs {
+		idx := i
+		callbacks[idx] = func(ID MessageID, message *ProducerMessage, e error) {
+			lock.Lock()
+			if e != nil && err == nil {
+				err = e
+			}
+			msgIDs[idx] = ID
+			lock.Unlock()
+			wg.Done()
+		}
+	}
+
+	p.internalSendBatchAsync(ctx, msgs, callbacks)
+
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return msgIDs, nil
+}
+
+// internalSendBatchAsync enqueues messages to be sent in a single batch, calling all the callbacks with the same
+// error if the batch fails
+func (p *partitionProducer) internalSendBatchAsync(ctx context.Context, msgs []*ProducerMessage,
+	callbacks []func(MessageID, *ProducerMessage, error)) {
+	requests := make([]*sendRequest, len(msgs))
+	for i, msg := range msgs {
+		requests[i] = &sendRequest{
+			ctx:              ctx,
+			msg:              msg,
+			callback:         p.withSendErrorInterceptors(p.withSendErrorStats(callbacks[i])),
+			flushImmediately: true,
+			publishTime:      time.Now(),
+		}
+	}
+
+	failAll := func(err error) {
+		for _, sr := range requests {
+			sr.callback(nil, sr.msg, err)
+		}
+	}
+
+	if p.getProducerState() != producerReady {
+		// Producer is closing or fenced
+		failAll(p.notReadyError())
+		return
+	}
+
+	if len(msgs) > p.maxPending {
+		// the batch would wait forever for the permits of its messages
+		failAll(errBatchTooLarge)
+		return
+	}
+
+	for _, msg := range msgs {
+		if msg.ReplicationClusters != nil || msg.DisableReplication ||
+			msg.DeliverAfter.Nanoseconds() > 0 || msg.DeliverAt.UnixNano() > 0 {
+			failAll(errBatchNotSupported)
+			return
+		}
+	}
+
+	for _, msg := range msgs {
+		p.options.Interceptors.BeforeSend(p, msg)
+		if err := p.options.Interceptors.CheckSend(p, msg); err != nil {
+			p.log.WithError(err).Debug("Message rejected by interceptor")
+			failAll(newError(MessageRejected, fmt.Sprintf("message rejected by interceptor: %v", err)))
+			return
+		}
+	}
+
+	for _, sr := range requests {
+		if err := p.encodeValue(sr); err != nil {
+			failAll(err)
+			return
+		}
+	}
+
+	for i, sr := range requests {
+		var err error
+		if p.options.DisableBlockIfQueueFull {
+			if !p.publishSemaphore.TryAcquire() {
+				err = errSendQueueIsFull
+			}
+		} else if !p.publishSemaphore.Acquire(ctx) {
+			err = errContextExpired
+		}
+
+		if err == nil && !p.reserveMem(ctx, sr.payloadSize()) {
+			p.publishSemaphore.Release()
+			if p.options.DisableBlockIfQueueFull {
+				err = errMemoryBufferIsFull
+			} else {
+				err = errContextExpired
+			}
+		}
+
+		if err != nil {
+			// give back what the previous messages of the batch acquired
+			for _, acquired := range requests[:i] {
+				p.releaseSemaphoreAndMem(acquired.reservedMem)
+			}
+			failAll(err)
+			return
+		}
+		sr.reservedMem = sr.payloadSize()
+		atomic.AddInt64(&p.pendingMessages, 1)
+	}
+
+	for _, msg := range msgs {
+		p.metrics.MessagesPending.Inc()
+		p.metrics.BytesPending.Add(float64(len(msg.Payload)))
+	}
+
+	p.eventsChan <- &sendBatchRequest{requests: requests}
+}
+
+// internalSendBatch sends the messages of the request in a batch of their own, after the messages already
+// buffered in the batch builder of the producer
+func (p *partitionProducer) internalSendBatch(request *sendBatchRequest) {
+	failAll := func(err error) {
+		for _, sr := range request.requests {
+			p.releaseSemaphoreAndMem(sr.reservedMem)
+			sr.callback(nil, sr.msg, err)
+		}
+	}
+
+	if p.getProducerState() == producerFenced {
+		failAll(errProducerFenced)
+		return
+	}
+
+	if p.batchBuilder.IsMultiBatches() {
+		p.internalFlushCurrentBatches()
+	} else {
+		p.internalFlushCurrentBatch()
+	}
+
+	batchBuilder, err := internal.NewBatchBuilder(uint(len(request.requests)), uint(p.cnx.GetMaxMessageSize()),
+		p.producerName, p.producerID, pb.CompressionType(p.options.CompressionType),
+		compression.Level(p.options.CompressionLevel), p, p.log, p.encryptor)
+	if err != nil {
+		failAll(err)
+		return
+	}
+	defer batchBuilder.Close()
+
+	for _, sr := range request.requests {
+		payload, err := p.encodePayload(sr)
+		if err != nil {
+			failAll(err)
+			return
+		}
+
+		smm := p.singleMessageMetadata(sr.msg, payload)
+		if !batchBuilder.Add(smm, p.sequenceIDGenerator, payload, sr, nil, sr.msg.DeliverAt, p.schemaVersion) {
+			p.log.WithField("messages", len(request.requests)).Error("unable to add messages to a single batch")
+			failAll(errBatchTooLarge)
+			return
+		}
+	}
+
+	batchData, sequenceID, callbacks, err := batchBuilder.Flush()
+	if err != nil {
+		failAll(err)
+		return
+	}
+
+	p.pendingQueue.Put(&pendingItem{
+		sentAt:       time.Now(),
+		batchData:    batchData,
+		sequenceID:   sequenceID,
+		sendRequests: callbacks,
+	})
+	p.cnx.WriteData(batchData)
+}
+
 func (p *partitionProducer) SendAsync(ctx context.Context, msg *ProducerMessage,
 	callback func(MessageID, *ProducerMessage, error)) {
 	p.internalSendAsync(ctx, msg, callback, false)
@@ -737,9 +1095,11 @@ func (p *partitionProducer) SendAsync(ctx context.Context, msg *ProducerMessage,
 
 func (p *partitionProducer) internalSendAsync(ctx context.Context, msg *ProducerMessage,
 	callback func(MessageID, *ProducerMessage, error), flushImmediately bool) {
+	callback = p.withSendErrorInterceptors(p.withSendErrorStats(callback))
+
 	if p.getProducerState() != producerReady {
-		// Producer is closing
-		callback(nil, msg, errProducerClosed)
+		// Producer is closing or fenced
+		callback(nil, msg, p.notReadyError())
 		return
 	}
 
@@ -752,6 +1112,17 @@ func (p *partitionProducer) internalSendAsync(ctx context.Context, msg *Producer
 	}
 	p.options.Interceptors.BeforeSend(p, msg)
 
+	if err := p.options.Interceptors.CheckSend(p, msg); err != nil {
+		p.log.WithError(err).Debug("Message rejected by interceptor")
+		callback(nil, msg, newError(MessageRejected, fmt.Sprintf("message rejected by interceptor: %v", err)))
+		return
+	}
+
+	if err := p.encodeValue(sr); err != nil {
+		callback(nil, msg, err)
+		return
+	}
+
 	if p.options.DisableBlockIfQueueFull {
 		if !p.publishSemaphore.TryAcquire() {
 			if callback != nil {
@@ -766,12 +1137,85 @@ func (p *partitionProducer) internalSendAsync(ctx context.Context, msg *Producer
 		}
 	}
 
+	if !p.reserveMem(ctx, sr.payloadSize()) {
+		p.publishSemaphore.Release()
+		if p.options.DisableBlockIfQueueFull {
+			callback(nil, msg, errMemoryBufferIsFull)
+		} else {
+			callback(nil, msg, errContextExpired)
+		}
+		return
+	}
+	sr.reservedMem = sr.payloadSize()
+	atomic.AddInt64(&p.pendingMessages, 1)
+
 	p.metrics.MessagesPending.Inc()
 	p.metrics.BytesPending.Add(float64(len(sr.msg.Payload)))
 
 	p.eventsChan <- sr
 }
 
+// withSendErrorInterceptors wraps a send callback to report every failed send to the interceptors
+func (p *partitionProducer) withSendErrorInterceptors(
+	callback func(MessageID, *ProducerMessage, error)) func(MessageID, *ProducerMessage, error) {
+	if len(p.options.Interceptors) == 0 {
+		return callback
+	}
+
+	return func(id MessageID, message *ProducerMessage, e error) {
+		if e != nil {
+			p.options.Interceptors.OnSendError(p, message, e)
+		}
+		if callback != nil {
+			callback(id, message, e)
+		}
+	}
+}
+
+// withSendErrorStats wraps a send callback to count the failed sends by Result
+func (p *partitionProducer) withSendErrorStats(
+	callback func(MessageID, *ProducerMessage, error)) func(MessageID, *ProducerMessage, error) {
+	return func(id MessageID, message *ProducerMessage, e error) {
+		if e != nil {
+			result := UnknownError
+			if pe, ok := e.(*Error); ok {
+				result = pe.Result()
+			}
+			p.sendErrorsLock.Lock()
+			p.sendErrors[result]++
+			p.sendErrorsLock.Unlock()
+		}
+		if callback != nil {
+			callback(id, message, e)
+		}
+	}
+}
+
+func (p *partitionProducer) addToBatchStats(size int) {
+	atomic.AddInt64(&p.batchMessages, 1)
+	atomic.AddInt64(&p.batchBytes, int64(size))
+}
+
+func (p *partitionProducer) resetBatchStats() {
+	atomic.StoreInt64(&p.batchMessages, 0)
+	atomic.StoreInt64(&p.batchBytes, 0)
+}
+
+// reserveMem reserves the memory of a payload in the memory limit of the client
+func (p *partitionProducer) reserveMem(ctx context.Context, size int64) bool {
+	if p.options.DisableBlockIfQueueFull {
+		return p.client.memLimit.TryReserveMemory(size)
+	}
+	return p.client.memLimit.ReserveMemory(ctx, size)
+}
+
+// releaseSemaphoreAndMem releases the pending message permit and the memory of the payload of a message
+func (p *partitionProducer) releaseSemaphoreAndMem(size int64) {
+	atomic.AddInt64(&p.pendingMessages, -1)
+	p.publishSemaphore.Release()
+	p.client.memLimit.ReleaseMemory(size)
+}
+
 func (p *partitionProducer) ReceivedSendReceipt(response *pb.CommandSendReceipt) {
 	pi, ok := p.pendingQueue.Peek().(*pendingItem)
 
@@ -807,9 +1251,12 @@ func (p *partitionProducer) ReceivedSendReceipt(response *pb.CommandSendReceipt)
 		sr := i.(*sendRequest)
 		if sr.msg != nil {
 			atomic.StoreInt64(&p.lastSequenceID, int64(pi.sequenceID))
-			p.publishSemaphore.Release()
+			p.releaseSemaphoreAndMem(sr.reservedMem)
 
 			p.metrics.PublishLatency.Observe(float64(now-sr.publishTime.UnixNano()) / 1.0e9)
+			atomic.AddInt64(&p.messagesSent, 1)
+			atomic.AddInt64(&p.bytesSent, int64(len(sr.msg.Payload)))
+			p.latencies.add(time.Unix(0, now), time.Duration(now-sr.publishTime.UnixNano()))
 			p.metrics.MessagesPublished.Inc()
 			p.metrics.MessagesPending.Dec()
 			payloadSize := float64(len(sr.msg.Payload))
@@ -829,7 +1276,9 @@ func (p *partitionProducer) ReceivedSendReceipt(response *pb.CommandSendReceipt)
 				sr.callback(msgID, sr.msg, nil)
 			}
 
-			p.options.Interceptors.OnSendAcknowledgement(p, sr.msg, msgID)
+			if sr.msg != nil {
+				p.options.Interceptors.OnSendAcknowledgement(p, sr.msg, msgID)
+			}
 		}
 	}
 
@@ -839,17 +1288,22 @@ func (p *partitionProducer) ReceivedSendReceipt(response *pb.CommandSendReceipt)
 
 func (p *partitionProducer) internalClose(req *closeProducer) {
 	defer req.waitGroup.Done()
-	if !p.casProducerState(producerReady, producerClosing) {
+	// a fenced producer was already closed by the broker
+	fenced := p.casProducerState(producerFenced, producerClosing)
+	if !fenced && !p.casProducerState(producerReady, producerClosing) {
 		return
 	}
 
 	p.log.Info("Closing producer")
 
-	id := p.client.rpcClient.NewRequestID()
-	_, err := p.client.rpcClient.RequestOnCnx(p.cnx, id, pb.BaseCommand_CLOSE_PRODUCER, &pb.CommandCloseProducer{
-		ProducerId: &p.producerID,
-		RequestId:  &id,
-	})
+	var err error
+	if !fenced {
+		id := p.client.rpcClient.NewRequestID()
+		_, err = p.client.rpcClient.RequestOnCnx(p.cnx, id, pb.BaseCommand_CLOSE_PRODUCER, &pb.CommandCloseProducer{
+			ProducerId: &p.producerID,
+			RequestId:  &id,
+		})
+	}
 
 	if err != nil {
 		p.log.WithError(err).Warn("Failed to close producer")
@@ -857,28 +1311,203 @@ func (p *partitionProducer) internalClose(req *closeProducer) {
 		p.log.Info("Closed producer")
 	}
 
+	p.setProducerState(producerClosed)
+	p.cnx.UnregisterListener(p.producerID)
+	p.batchFlushTicker.Stop()
+
+	p.failPendingMessages(errProducerClosed)
 	if err = p.batchBuilder.Close(); err != nil {
 		p.log.WithError(err).Warn("Failed to close batch builder")
 	}
 
-	p.setProducerState(producerClosed)
-	p.cnx.UnregisterListener(p.producerID)
-	p.batchFlushTicker.Stop()
+	p.failQueuedRequests()
+	close(p.closedCh)
+}
+
+// failQueuedRequests fails the requests left in eventsChan, which the events loop will not handle anymore
+func (p *partitionProducer) failQueuedRequests() {
+	for {
+		select {
+		case i := <-p.eventsChan:
+			switch v := i.(type) {
+			case *sendRequest:
+				p.failSendRequests([]interface{}{v}, errProducerClosed)
+			case *sendBatchRequest:
+				for _, sr := range v.requests {
+					p.failSendRequests([]interface{}{sr}, errProducerClosed)
+				}
+			case *flushRequest:
+				v.callback(errProducerClosed)
+			case *closeProducer:
+				v.waitGroup.Done()
+			}
+		default:
+			return
+		}
+	}
+}
+
+// failPendingMessages fails the messages in the batch builder and the pending queue, releasing their permits and
+// their memory
+func (p *partitionProducer) failPendingMessages(err error) {
+	var sendRequests []interface{}
+	if p.batchBuilder.IsMultiBatches() {
+		_, _, callbacks, _ := p.batchBuilder.FlushBatches()
+		for _, cbs := range callbacks {
+			sendRequests = append(sendRequests, cbs...)
+		}
+	} else {
+		_, _, callbacks, _ := p.batchBuilder.Flush()
+		sendRequests = callbacks
+	}
+	p.resetBatchStats()
+	p.failSendRequests(sendRequests, err)
+
+	for item := p.pendingQueue.Poll(); item != nil; item = p.pendingQueue.Poll() {
+		pi := item.(*pendingItem)
+		pi.Lock()
+		if !pi.completed {
+			p.failSendRequests(pi.sendRequests, err)
+			pi.Complete()
+		}
+		pi.Unlock()
+	}
+}
+
+func (p *partitionProducer) failSendRequests(sendRequests []interface{}, err error) {
+	for _, i := range sendRequests {
+		sr, ok := i.(*sendRequest)
+		if !ok {
+			continue
+		}
+		if sr.msg != nil {
+			p.releaseSemaphoreAndMem(sr.reservedMem)
+			p.metrics.MessagesPending.Dec()
+			p.metrics.BytesPending.Sub(float64(len(sr.msg.Payload)))
+		}
+		if sr.callback != nil {
+			sr.callback(nil, sr.msg, err)
+		}
+	}
 }
 
 func (p *partitionProducer) LastSequenceID() int64 {
 	return atomic.LoadInt64(&p.lastSequenceID)
 }
 
+func (p *partitionProducer) Stats() ProducerStats {
+	stats := newProducerStats()
+	stats.MessagesSent = atomic.LoadInt64(&p.messagesSent)
+	stats.BytesSent = atomic.LoadInt64(&p.bytesSent)
+	stats.PendingMessages = atomic.LoadInt64(&p.pendingMessages)
+	stats.BatchMessages = atomic.LoadInt64(&p.batchMessages)
+	stats.BatchBytes = atomic.LoadInt64(&p.batchBytes)
+
+	p.sendErrorsLock.Lock()
+	for result, count := range p.sendErrors {
+		stats.SendErrors[result] = count
+	}
+	p.sendErrorsLock.Unlock()
+
+	stats.PublishLatency = newPublishLatencyStats(p.publishLatencies())
+	stats.LastSequenceIDs[int(p.partitionIdx)] = p.LastSequenceID()
+	return stats
+}
+
+func (p *partitionProducer) publishLatencies() []time.Duration {
+	return p.latencies.latencies(time.Now())
+}
+
 func (p *partitionProducer) Flush() error {
-	wg := sync.WaitGroup{}
-	wg.Add(1)
+	return p.FlushWithContext(context.Background())
+}
 
-	cp := &flushRequest{&wg, nil}
-	p.eventsChan <- cp
+func (p *partitionProducer) FlushWithContext(ctx context.Context) error {
+	if p.getProducerState() != producerReady {
+		return p.notReadyError()
+	}
 
-	wg.Wait()
-	return cp.err
+	// the callback may complete after the context, it must not block
+	done := make(chan error, 1)
+	fr := &flushRequest{callback: func(err error) {
+		done <- err
+	}}
+
+	select {
+	case p.eventsChan <- fr:
+	case <-p.closedCh:
+		return errProducerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-p.closedCh:
+		// the request was either failed by the close, or enqueued after it
+		select {
+		case err := <-done:
+			return err
+		default:
+			return errProducerClosed
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *partitionProducer) FlushAsync(callback func(error)) {
+	if callback == nil {
+		callback = func(error) {}
+	}
+
+	if p.getProducerState() != producerReady {
+		callback(p.notReadyError())
+		return
+	}
+
+	// the callback is called once, by the events loop or by the close of the producer
+	var once sync.Once
+	fr := &flushRequest{callback: func(err error) {
+		once.Do(func() {
+			callback(err)
+		})
+	}}
+
+	select {
+	case p.eventsChan <- fr:
+		p.failFlushAfterClose(fr)
+	default:
+		// the events queue is full, enqueue the request without blocking the caller
+		go func() {
+			select {
+			case p.eventsChan <- fr:
+				p.failFlushAfterClose(fr)
+			case <-p.closedCh:
+				fr.callback(errProducerClosed)
+			}
+		}()
+	}
+}
+
+// failFlushAfterClose fails an enqueued flush request once the producer is closed, in case it was enqueued after
+// the close failed the requests left in eventsChan
+func (p *partitionProducer) failFlushAfterClose(fr *flushRequest) {
+	if state := p.getProducerState(); state == producerClosing || state == producerClosed {
+		go func() {
+			<-p.closedCh
+			fr.callback(errProducerClosed)
+		}()
+	}
+}
+
+// notReadyError is the error of the requests made to a producer which is not ready
+func (p *partitionProducer) notReadyError() error {
+	if p.getProducerState() == producerFenced {
+		return errProducerFenced
+	}
+	return errProducerClosed
 }
 
 func (p *partitionProducer) getProducerState() producerState {
@@ -896,7 +1525,7 @@ func (p *partitionProducer) casProducerState(oldState, newState producerState) b
 }
 
 func (p *partitionProducer) Close() {
-	if p.getProducerState() != producerReady {
+	if state := p.getProducerState(); state != producerReady && state != producerFenced {
 		// Producer is closing
 		return
 	}
@@ -916,6 +1545,23 @@ type sendRequest struct {
 	callback         func(MessageID, *ProducerMessage, error)
 	publishTime      time.Time
 	flushImmediately bool
+
+	// schemaPayload is the value of the message encoded with the schema of the producer
+	schemaPayload []byte
+	// reservedMem is the memory reserved for the payload in the memory limit of the client
+	reservedMem int64
+}
+
+// payloadSize is the size of the payload of the message of the request, reserved in the memory limit of the client
+func (sr *sendRequest) payloadSize() int64 {
+	if sr.msg.Payload != nil {
+		return int64(len(sr.msg.Payload))
+	}
+	return int64(len(sr.schemaPayload))
+}
+
+type sendBatchRequest struct {
+	requests []*sendRequest
 }
 
 type closeProducer struct {
@@ -923,8 +1569,8 @@ type closeProducer struct {
 }
 
 type flushRequest struct {
-	waitGroup *sync.WaitGroup
-	err       error
+	// callback is called once the messages enqueued before the request are persisted
+	callback func(error)
 }
 
 func (i *pendingItem) Complete() {
@@ -0,0 +1,168 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// publishLatencyWindow is the period over which the publish latency percentiles are computed
+	publishLatencyWindow = time.Minute
+
+	// maxPublishLatencySamples is the number of latencies kept by each partition producer in the window
+	maxPublishLatencySamples = 1024
+)
+
+// ProducerStats is a snapshot of the statistics of a producer, merged over its partitions
+type ProducerStats struct {
+	// MessagesSent is the number of messages acknowledged by the broker
+	MessagesSent int64
+
+	// BytesSent is the size of the payloads of the messages acknowledged by the broker
+	BytesSent int64
+
+	// SendErrors is the number of failed sends by Result, the errors without Result are counted as UnknownError
+	SendErrors map[Result]int64
+
+	// PendingMessages is the number of messages waiting to be acknowledged by the broker
+	PendingMessages int64
+
+	// BatchMessages is the number of messages in the batches being built
+	BatchMessages int64
+
+	// BatchBytes is the size of the payloads of the messages in the batches being built
+	BatchBytes int64
+
+	// PublishLatency is the distribution of the time between the sends and their acknowledgement over the last
+	// minute, limited to the last 1024 messages of each partition
+	PublishLatency PublishLatencyStats
+
+	// LastSequenceIDs is the last sequence id published by partition, the partitions of the producers not started
+	// yet are missing
+	LastSequenceIDs map[int]int64
+}
+
+// PublishLatencyStats are percentiles of the publish latency
+type PublishLatencyStats struct {
+	// Count is the number of latencies in the window
+	Count int
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// producerStatsSource is implemented by the producers of the partitions to merge their statistics
+type producerStatsSource interface {
+	Stats() ProducerStats
+
+	// publishLatencies returns the latencies of the window
+	publishLatencies() []time.Duration
+}
+
+func newProducerStats() ProducerStats {
+	return ProducerStats{
+		SendErrors:      make(map[Result]int64),
+		LastSequenceIDs: make(map[int]int64),
+	}
+}
+
+// merge adds the counters of the statistics of a partition
+func (s *ProducerStats) merge(other ProducerStats) {
+	s.MessagesSent += other.MessagesSent
+	s.BytesSent += other.BytesSent
+	s.PendingMessages += other.PendingMessages
+	s.BatchMessages += other.BatchMessages
+	s.BatchBytes += other.BatchBytes
+	for result, count := range other.SendErrors {
+		s.SendErrors[result] += count
+	}
+	for partition, sequenceID := range other.LastSequenceIDs {
+		s.LastSequenceIDs[partition] = sequenceID
+	}
+}
+
+// newPublishLatencyStats computes the percentiles of latencies, the slice is sorted in place
+func newPublishLatencyStats(latencies []time.Duration) PublishLatencyStats {
+	if len(latencies) == 0 {
+		return PublishLatencyStats{}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	percentile := func(p int) time.Duration {
+		return latencies[(len(latencies)-1)*p/100]
+	}
+
+	return PublishLatencyStats{
+		Count: len(latencies),
+		P50:   percentile(50),
+		P95:   percentile(95),
+		P99:   percentile(99),
+		Max:   latencies[len(latencies)-1],
+	}
+}
+
+type latencySample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+// latencyWindow keeps the last latencies in a ring buffer
+type latencyWindow struct {
+	sync.Mutex
+	samples []latencySample
+	next    int
+}
+
+func newLatencyWindow() *latencyWindow {
+	return &latencyWindow{
+		samples: make([]latencySample, 0, maxPublishLatencySamples),
+	}
+}
+
+func (w *latencyWindow) add(at time.Time, latency time.Duration) {
+	w.Lock()
+	defer w.Unlock()
+
+	sample := latencySample{at: at, latency: latency}
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, sample)
+		return
+	}
+	w.samples[w.next] = sample
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+// latencies returns the latencies added during the window before now
+func (w *latencyWindow) latencies(now time.Time) []time.Duration {
+	w.Lock()
+	defer w.Unlock()
+
+	latencies := make([]time.Duration, 0, len(w.samples))
+	for _, s := range w.samples {
+		if now.Sub(s.at) <= publishLatencyWindow {
+			latencies = append(latencies, s.latency)
+		}
+	}
+	return latencies
+}
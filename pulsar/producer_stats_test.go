@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishLatencyStats(t *testing.T) {
+	assert.Equal(t, PublishLatencyStats{}, newPublishLatencyStats(nil))
+
+	latencies := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	stats := newPublishLatencyStats(latencies)
+	assert.Equal(t, 100, stats.Count)
+	assert.Equal(t, 50*time.Millisecond, stats.P50)
+	assert.Equal(t, 95*time.Millisecond, stats.P95)
+	assert.Equal(t, 99*time.Millisecond, stats.P99)
+	assert.Equal(t, 100*time.Millisecond, stats.Max)
+}
+
+func TestLatencyWindow(t *testing.T) {
+	w := newLatencyWindow()
+	now := time.Now()
+
+	w.add(now.Add(-2*publishLatencyWindow), time.Second)
+	w.add(now, time.Millisecond)
+	assert.Equal(t, []time.Duration{time.Millisecond}, w.latencies(now))
+
+	for i := 0; i < maxPublishLatencySamples+10; i++ {
+		w.add(now, time.Duration(i))
+	}
+	latencies := w.latencies(now)
+	assert.Equal(t, maxPublishLatencySamples, len(latencies))
+	stats := newPublishLatencyStats(latencies)
+	assert.Equal(t, time.Duration(10), latencies[0])
+	assert.Equal(t, time.Duration(maxPublishLatencySamples+9), stats.Max)
+}
+
+func TestProducerStatsMerge(t *testing.T) {
+	stats := newProducerStats()
+	stats.merge(ProducerStats{
+		MessagesSent:    3,
+		BytesSent:       30,
+		SendErrors:      map[Result]int64{TimeoutError: 1},
+		PendingMessages: 2,
+		BatchMessages:   1,
+		BatchBytes:      10,
+		LastSequenceIDs: map[int]int64{0: 4},
+	})
+	stats.merge(ProducerStats{
+		MessagesSent:    2,
+		BytesSent:       20,
+		SendErrors:      map[Result]int64{TimeoutError: 2, ProducerQueueIsFull: 1},
+		LastSequenceIDs: map[int]int64{1: -1},
+	})
+
+	assert.Equal(t, int64(5), stats.MessagesSent)
+	assert.Equal(t, int64(50), stats.BytesSent)
+	assert.Equal(t, map[Result]int64{TimeoutError: 3, ProducerQueueIsFull: 1}, stats.SendErrors)
+	assert.Equal(t, int64(2), stats.PendingMessages)
+	assert.Equal(t, int64(1), stats.BatchMessages)
+	assert.Equal(t, int64(10), stats.BatchBytes)
+	assert.Equal(t, map[int]int64{0: 4, 1: -1}, stats.LastSequenceIDs)
+}
+
+func TestProducerSendErrorStats(t *testing.T) {
+	p := &partitionProducer{
+		sendErrors:     make(map[Result]int64),
+		latencies:      newLatencyWindow(),
+		lastSequenceID: -1,
+		partitionIdx:   2,
+	}
+
+	var calls int
+	callback := p.withSendErrorStats(func(id MessageID, message *ProducerMessage, e error) {
+		calls++
+	})
+	callback(nil, nil, errSendTimeout)
+	callback(nil, nil, errSendTimeout)
+	callback(nil, nil, errors.New("schema error"))
+	callback(newMessageID(1, 2, 0, 2), nil, nil)
+	assert.Equal(t, 4, calls)
+
+	stats := p.Stats()
+	assert.Equal(t, map[Result]int64{TimeoutError: 2, UnknownError: 1}, stats.SendErrors)
+	assert.Equal(t, map[int]int64{2: -1}, stats.LastSequenceIDs)
+	assert.Equal(t, 0, stats.PublishLatency.Count)
+}
@@ -19,15 +19,18 @@ package pulsar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar/internal"
+	pb "github.com/apache/pulsar-client-go/pulsar/internal/pulsar_proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/apache/pulsar-client-go/pulsar/crypto"
@@ -1207,6 +1210,78 @@ func TestProducerWithInterceptors(t *testing.T) {
 	assert.Equal(t, 10, metric.ackn)
 }
 
+// piiRejectingInterceptor rejects the messages carrying a "pii" property
+type piiRejectingInterceptor struct {
+	metricProduceInterceptor
+	errors []error
+}
+
+func (x *piiRejectingInterceptor) CheckSend(producer Producer, message *ProducerMessage) error {
+	if _, ok := message.Properties["pii"]; ok {
+		return errors.New("message contains pii")
+	}
+	return nil
+}
+
+func (x *piiRejectingInterceptor) OnSendError(producer Producer, message *ProducerMessage, err error) {
+	x.errors = append(x.errors, err)
+}
+
+func TestProducerWithRejectingInterceptors(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:            topic,
+		SubscriptionName: "my-sub",
+		Type:             Exclusive,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	interceptor := &piiRejectingInterceptor{}
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:        topic,
+		Interceptors: ProducerInterceptors{interceptor},
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	for i := 0; i < 10; i++ {
+		msg := &ProducerMessage{
+			Payload: []byte(fmt.Sprintf("hello-%d", i)),
+		}
+		if i%2 == 1 {
+			msg.Properties = map[string]string{"pii": "true"}
+		}
+		_, err := producer.Send(ctx, msg)
+		if i%2 == 1 {
+			assert.NotNil(t, err)
+			assert.Equal(t, MessageRejected, err.(*Error).Result())
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		msg, err := consumer.Receive(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("hello-%d", i*2)), msg.Payload())
+		consumer.Ack(msg)
+	}
+
+	assert.Equal(t, 10, interceptor.sendn)
+	assert.Equal(t, 5, interceptor.ackn)
+	assert.Len(t, interceptor.errors, 5)
+}
+
 func TestProducerSendAfterClose(t *testing.T) {
 	client, err := NewClient(ClientOptions{
 		URL: serviceURL,
@@ -1272,3 +1347,570 @@ func TestExactlyOnceWithProducerNameSpecified(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, producer3)
 }
+
+func TestProducerAccessModeExclusive(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topicName := newTopicName()
+
+	p1, err := client.CreateProducer(ProducerOptions{
+		Topic:              topicName,
+		ProducerAccessMode: ProducerAccessModeExclusive,
+	})
+	assert.Nil(t, err)
+	defer p1.Close()
+
+	_, err = client.CreateProducer(ProducerOptions{
+		Topic:              topicName,
+		ProducerAccessMode: ProducerAccessModeExclusive,
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, ProducerBusy, err.(*Error).Result())
+
+	_, err = client.CreateProducer(ProducerOptions{
+		Topic: topicName,
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, ProducerBusy, err.(*Error).Result())
+}
+
+func TestProducerAccessModeWaitForExclusive(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topicName := newTopicName()
+
+	p1, err := client.CreateProducer(ProducerOptions{
+		Topic:              topicName,
+		ProducerAccessMode: ProducerAccessModeExclusive,
+	})
+	assert.Nil(t, err)
+
+	created := make(chan Producer)
+	go func() {
+		p2, err := client.CreateProducer(ProducerOptions{
+			Topic:              topicName,
+			ProducerAccessMode: ProducerAccessModeWaitForExclusive,
+		})
+		assert.Nil(t, err)
+		created <- p2
+	}()
+
+	select {
+	case <-created:
+		t.Fatal("the producer should wait for the exclusive access")
+	case <-time.After(2 * time.Second):
+	}
+
+	p1.Close()
+
+	select {
+	case p2 := <-created:
+		assert.NotNil(t, p2)
+		_, err = p2.Send(context.Background(), &ProducerMessage{Payload: []byte("hello")})
+		assert.Nil(t, err)
+		p2.Close()
+	case <-time.After(10 * time.Second):
+		t.Fatal("the producer should be granted the exclusive access")
+	}
+}
+
+func TestProducerAccessModeErrors(t *testing.T) {
+	err := toProducerError(&internal.ServerError{Code: pb.ServerError_ProducerBusy, Message: "busy"})
+	assert.Equal(t, ProducerBusy, err.(*Error).Result())
+	assert.Equal(t, "server error: ProducerBusy: busy: ProducerBusy", err.Error())
+
+	err = toProducerError(&internal.ServerError{Code: pb.ServerError_ProducerFenced, Message: "fenced"})
+	assert.Equal(t, ProducerFenced, err.(*Error).Result())
+
+	serverErr := &internal.ServerError{Code: pb.ServerError_TopicNotFound, Message: "not found"}
+	assert.Equal(t, serverErr, toProducerError(serverErr))
+
+	other := errors.New("connection closed")
+	assert.Equal(t, other, toProducerError(other))
+
+	assert.Equal(t, pb.ProducerAccessMode_Shared, toProtoProducerAccessMode(ProducerAccessModeShared))
+	assert.Equal(t, pb.ProducerAccessMode_Exclusive, toProtoProducerAccessMode(ProducerAccessModeExclusive))
+	assert.Equal(t, pb.ProducerAccessMode_WaitForExclusive,
+		toProtoProducerAccessMode(ProducerAccessModeWaitForExclusive))
+}
+
+func TestProducerFencedErrors(t *testing.T) {
+	p := &partitionProducer{options: &ProducerOptions{}, sendErrors: make(map[Result]int64)}
+	p.setProducerState(producerFenced)
+
+	var sendErr error
+	p.SendAsync(context.Background(), &ProducerMessage{}, func(id MessageID, msg *ProducerMessage, err error) {
+		sendErr = err
+	})
+	assert.Equal(t, ProducerFenced, sendErr.(*Error).Result())
+
+	assert.Equal(t, errProducerFenced, p.FlushWithContext(context.Background()))
+}
+
+func TestProducerMemoryLimit(t *testing.T) {
+	c, err := NewClient(ClientOptions{
+		URL:              serviceURL,
+		MemoryLimitBytes: 100,
+	})
+	assert.Nil(t, err)
+	defer c.Close()
+	memLimit := c.(*client).memLimit
+
+	producer, err := c.CreateProducer(ProducerOptions{
+		Topic:                   newTopicName(),
+		DisableBlockIfQueueFull: true,
+		BatchingMaxPublishDelay: 100 * time.Second,
+		BatchingMaxSize:         1024,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	producer.SendAsync(context.Background(), &ProducerMessage{
+		Payload: make([]byte, 60),
+	}, func(id MessageID, message *ProducerMessage, e error) {})
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Payload: make([]byte, 60),
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, ProducerQueueIsFull, err.(*Error).Result())
+	assert.Equal(t, int64(60), memLimit.CurrentUsage())
+
+	assert.Nil(t, producer.Flush())
+	assert.Equal(t, int64(0), memLimit.CurrentUsage())
+}
+
+func TestProducerMemoryLimitReleasedOnClose(t *testing.T) {
+	c, err := NewClient(ClientOptions{
+		URL:              serviceURL,
+		MemoryLimitBytes: 100,
+	})
+	assert.Nil(t, err)
+	defer c.Close()
+	memLimit := c.(*client).memLimit
+
+	p, err := c.CreateProducer(ProducerOptions{
+		Topic:                   newTopicName(),
+		Schema:                  NewStringSchema(nil),
+		DisableBlockIfQueueFull: true,
+		BatchingMaxPublishDelay: 100 * time.Second,
+		BatchingMaxSize:         1024,
+	})
+	assert.Nil(t, err)
+
+	errs := make(chan error, 1)
+	p.SendAsync(context.Background(), &ProducerMessage{
+		Value: strings.Repeat("a", 60),
+	}, func(id MessageID, message *ProducerMessage, e error) {
+		errs <- e
+	})
+	assert.Equal(t, int64(60), memLimit.CurrentUsage())
+
+	p.Close()
+	assert.Equal(t, ProducerClosed, (<-errs).(*Error).Result())
+	assert.Equal(t, int64(0), memLimit.CurrentUsage())
+}
+
+func TestLazyStartPartitionedProducers(t *testing.T) {
+	topicName := newTopicName()
+
+	// call admin api to make it partitioned
+	url := adminURL + "/" + "admin/v2/persistent/public/default/" + topicName + "/partitions"
+	makeHTTPCall(t, http.MethodPut, url, "4")
+
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	p, err := client.CreateProducer(ProducerOptions{
+		Topic:                         topicName,
+		LazyStartPartitionedProducers: true,
+		MessageRouter: func(msg *ProducerMessage, metadata TopicMetadata) int {
+			return 2
+		},
+	})
+	assert.Nil(t, err)
+	defer p.Close()
+
+	producers := p.(*producer).producers
+	assert.Equal(t, 4, len(producers))
+	assert.IsType(t, &partitionProducer{}, producers[0])
+	for i := 1; i < 4; i++ {
+		assert.Nil(t, producers[i].(*lazyPartitionProducer).started())
+	}
+	assert.NotEmpty(t, p.Name())
+
+	_, err = p.Send(context.Background(), &ProducerMessage{Payload: []byte("hello")})
+	assert.Nil(t, err)
+
+	assert.Nil(t, producers[1].(*lazyPartitionProducer).started())
+	assert.NotNil(t, producers[2].(*lazyPartitionProducer).started())
+	assert.Nil(t, producers[3].(*lazyPartitionProducer).started())
+	assert.Equal(t, int64(0), p.LastSequenceID())
+	assert.Nil(t, p.Flush())
+}
+
+func TestLazyPartitionProducerClosed(t *testing.T) {
+	p := newLazyPartitionProducer(nil, "my-topic-partition-1", &ProducerOptions{}, 1, nil)
+	p.Close()
+
+	assert.Equal(t, int64(-1), p.LastSequenceID())
+	assert.Nil(t, p.Flush())
+	assert.Equal(t, "", p.Name())
+
+	_, err := p.Send(context.Background(), &ProducerMessage{Payload: []byte("hello")})
+	assert.Equal(t, errProducerClosed, err)
+
+	var callbackErr error
+	p.SendAsync(context.Background(), &ProducerMessage{Payload: []byte("hello")},
+		func(id MessageID, message *ProducerMessage, e error) {
+			callbackErr = e
+		})
+	assert.Equal(t, errProducerClosed, callbackErr)
+}
+
+func TestLazyPartitionProducerFlushDuringCreation(t *testing.T) {
+	topicName := newTopicName()
+
+	// call admin api to make it partitioned
+	url := adminURL + "/" + "admin/v2/persistent/public/default/" + topicName + "/partitions"
+	makeHTTPCall(t, http.MethodPut, url, "2")
+
+	c, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer c.Close()
+
+	p, err := c.CreateProducer(ProducerOptions{
+		Topic:                         topicName,
+		LazyStartPartitionedProducers: true,
+		MessageRouter: func(msg *ProducerMessage, metadata TopicMetadata) int {
+			return 1
+		},
+	})
+	assert.Nil(t, err)
+	defer p.Close()
+
+	var sent int32
+	for i := 0; i < 10; i++ {
+		p.SendAsync(context.Background(), &ProducerMessage{Payload: []byte("hello")},
+			func(id MessageID, message *ProducerMessage, e error) {
+				assert.Nil(t, e)
+				atomic.AddInt32(&sent, 1)
+			})
+	}
+
+	// the partition producer is still being created, the flush waits for the queued messages
+	assert.Nil(t, p.Flush())
+	assert.Equal(t, int32(10), atomic.LoadInt32(&sent))
+}
+
+func TestLazyPartitionProducerFlushWaitsForCreation(t *testing.T) {
+	p := newLazyPartitionProducer(nil, "my-topic-partition-1", &ProducerOptions{}, 1, nil)
+	creation := &producerCreation{done: make(chan struct{})}
+	p.creation = creation
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, p.FlushWithContext(ctx))
+
+	flushErrs := make(chan error, 2)
+	p.FlushAsync(func(err error) {
+		flushErrs <- err
+	})
+	go func() {
+		flushErrs <- p.Flush()
+	}()
+
+	select {
+	case <-flushErrs:
+		t.Fatal("flush completed before the creation of the producer")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	creation.err = errProducerClosed
+	close(creation.done)
+	assert.Equal(t, errProducerClosed, <-flushErrs)
+	assert.Equal(t, errProducerClosed, <-flushErrs)
+
+	// close waits for the running creation
+	p.Close()
+}
+
+func TestProducerSendBatch(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topicName := newTopicName()
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:            topicName,
+		SubscriptionName: "my-sub",
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: topicName,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	ctx := context.Background()
+	_, err = producer.Send(ctx, &ProducerMessage{Payload: []byte("before")})
+	assert.Nil(t, err)
+
+	msgs := make([]*ProducerMessage, 3)
+	for i := range msgs {
+		msgs[i] = &ProducerMessage{Payload: []byte(fmt.Sprintf("batch-%d", i))}
+	}
+	ids, err := producer.SendBatch(ctx, msgs)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(ids))
+	for i, id := range ids {
+		assert.Equal(t, ids[0].LedgerID(), id.LedgerID())
+		assert.Equal(t, ids[0].EntryID(), id.EntryID())
+		assert.Equal(t, int32(i), id.BatchIdx())
+	}
+
+	msg, err := consumer.Receive(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "before", string(msg.Payload()))
+	for i := range msgs {
+		msg, err := consumer.Receive(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("batch-%d", i), string(msg.Payload()))
+		assert.Equal(t, ids[i].EntryID(), msg.ID().EntryID())
+		assert.Equal(t, ids[i].BatchIdx(), msg.ID().BatchIdx())
+	}
+}
+
+func TestProducerSendBatchTooLarge(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: newTopicName(),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	msgs := make([]*ProducerMessage, 3)
+	for i := range msgs {
+		msgs[i] = &ProducerMessage{Payload: make([]byte, internal.MaxMessageSize/2)}
+	}
+	ids, err := producer.SendBatch(context.Background(), msgs)
+	assert.Nil(t, ids)
+	assert.NotNil(t, err)
+	assert.Equal(t, AddToBatchFailed, err.(*Error).Result())
+
+	// the producer is still usable
+	_, err = producer.Send(context.Background(), &ProducerMessage{Payload: []byte("hello")})
+	assert.Nil(t, err)
+
+	ids, err = producer.SendBatch(context.Background(), []*ProducerMessage{
+		{Payload: []byte("hello"), DeliverAfter: time.Minute},
+	})
+	assert.Nil(t, ids)
+	assert.Equal(t, InvalidMessage, err.(*Error).Result())
+}
+
+func TestProducerFlushWithContext(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:                   newTopicName(),
+		BatchingMaxPublishDelay: 100 * time.Second,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	producer.SendAsync(context.Background(), &ProducerMessage{Payload: []byte("hello")},
+		func(id MessageID, message *ProducerMessage, e error) {})
+	assert.Equal(t, context.Canceled, producer.FlushWithContext(ctx))
+
+	// the producer is still usable
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	assert.Nil(t, producer.FlushWithContext(ctx))
+	assert.Equal(t, int64(0), producer.LastSequenceID())
+}
+
+func TestProducerFlushAsync(t *testing.T) {
+	topicName := newTopicName()
+
+	// call admin api to make it partitioned
+	url := adminURL + "/" + "admin/v2/persistent/public/default/" + topicName + "/partitions"
+	makeHTTPCall(t, http.MethodPut, url, "3")
+
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:                   topicName,
+		BatchingMaxPublishDelay: 100 * time.Second,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	var acked int32
+	for i := 0; i < 10; i++ {
+		producer.SendAsync(context.Background(), &ProducerMessage{Key: strconv.Itoa(i), Payload: []byte("hello")},
+			func(id MessageID, message *ProducerMessage, e error) {
+				assert.Nil(t, e)
+				atomic.AddInt32(&acked, 1)
+			})
+	}
+
+	done := make(chan error, 1)
+	producer.FlushAsync(func(err error) {
+		done <- err
+	})
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+		assert.Equal(t, int32(10), atomic.LoadInt32(&acked))
+	case <-time.After(10 * time.Second):
+		t.Fatal("the flush did not complete")
+	}
+}
+
+func TestProducerFlushClosed(t *testing.T) {
+	p := &partitionProducer{}
+	p.setProducerState(producerClosed)
+
+	assert.Equal(t, errProducerClosed, p.FlushWithContext(context.Background()))
+
+	var flushErr error
+	p.FlushAsync(func(err error) {
+		flushErr = err
+	})
+	assert.Equal(t, errProducerClosed, flushErr)
+
+	var called bool
+	(&producer{}).FlushAsync(func(err error) {
+		called = true
+		assert.Nil(t, err)
+	})
+	assert.True(t, called)
+}
+
+func TestProducerFlushAsyncNonBlocking(t *testing.T) {
+	p := &partitionProducer{eventsChan: make(chan interface{}, 1)}
+	p.setProducerState(producerReady)
+
+	// the events queue is full
+	p.eventsChan <- &flushRequest{}
+	p.FlushAsync(nil)
+
+	<-p.eventsChan
+	fr := (<-p.eventsChan).(*flushRequest)
+	fr.callback(nil)
+
+	p.setProducerState(producerClosed)
+	p.FlushAsync(nil)
+	(&producer{}).FlushAsync(nil)
+}
+
+func TestProducerCloseWithQueuedFlush(t *testing.T) {
+	p := &partitionProducer{eventsChan: make(chan interface{}, 1), closedCh: make(chan struct{})}
+	p.setProducerState(producerReady)
+
+	queuedErr := make(chan error, 1)
+	p.eventsChan <- &flushRequest{callback: func(err error) {
+		queuedErr <- err
+	}}
+
+	// the events queue is full, the request waits for a slot
+	asyncErr := make(chan error, 1)
+	p.FlushAsync(func(err error) {
+		asyncErr <- err
+	})
+
+	// the events loop stops with the requests still queued
+	p.setProducerState(producerClosed)
+	p.failQueuedRequests()
+	close(p.closedCh)
+
+	assert.Equal(t, errProducerClosed, <-queuedErr)
+	assert.Equal(t, errProducerClosed, <-asyncErr)
+
+	// a request enqueued after the close does not wait forever
+	p.setProducerState(producerReady)
+	assert.Equal(t, errProducerClosed, p.FlushWithContext(context.Background()))
+}
+
+func TestProducerStats(t *testing.T) {
+	topicName := newTopicName()
+
+	// call admin api to make it partitioned
+	url := adminURL + "/" + "admin/v2/persistent/public/default/" + topicName + "/partitions"
+	makeHTTPCall(t, http.MethodPut, url, "2")
+
+	client, err := NewClient(ClientOptions{
+		URL: serviceURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:                   topicName,
+		BatchingMaxPublishDelay: 100 * time.Second,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		_, err := producer.Send(ctx, &ProducerMessage{Key: strconv.Itoa(i), Payload: []byte("hello")})
+		assert.Nil(t, err)
+	}
+	producer.SendAsync(ctx, &ProducerMessage{Key: "pending", Payload: []byte("hello")},
+		func(id MessageID, message *ProducerMessage, e error) {})
+
+	_, err = producer.Send(ctx, &ProducerMessage{Payload: make([]byte, internal.MaxMessageSize+1)})
+	assert.NotNil(t, err)
+
+	stats := producer.Stats()
+	assert.Equal(t, int64(10), stats.MessagesSent)
+	assert.Equal(t, int64(50), stats.BytesSent)
+	assert.Equal(t, int64(1), stats.PendingMessages)
+	assert.Equal(t, int64(1), stats.BatchMessages)
+	assert.Equal(t, int64(5), stats.BatchBytes)
+	assert.Equal(t, map[Result]int64{MessageTooBig: 1}, stats.SendErrors)
+	assert.Equal(t, 10, stats.PublishLatency.Count)
+	assert.True(t, stats.PublishLatency.P50 <= stats.PublishLatency.Max)
+	assert.Equal(t, 2, len(stats.LastSequenceIDs))
+
+	assert.Nil(t, producer.Flush())
+	stats = producer.Stats()
+	assert.Equal(t, int64(11), stats.MessagesSent)
+	assert.Equal(t, int64(0), stats.PendingMessages)
+	assert.Equal(t, int64(0), stats.BatchMessages)
+}
@@ -31,9 +31,18 @@ type ReaderMessage struct {
 // ReaderOptions abstraction Reader options to use.
 type ReaderOptions struct {
 	// Topic specify the topic this consumer will subscribe on.
-	// This argument is required when constructing the reader.
+	// Either a topic, a list of topics or a topics pattern are required when constructing the reader.
 	Topic string
 
+	// Specify a list of topics this reader will read from, their messages are merged into a single stream.
+	// The StartMessageID must be either EarliestMessageID or LatestMessageID.
+	Topics []string
+
+	// Specify a regular expression to read from all the topics of a namespace matching it, eg.
+	// `persistent://public/default/orders-.*`. The topics are discovered every AutoDiscoveryPeriod.
+	// The StartMessageID must be either EarliestMessageID or LatestMessageID.
+	TopicsPattern string
+
 	// Name set the reader name.
 	Name string
 
@@ -48,14 +57,19 @@ type ReaderOptions struct {
 	//  * `MessageID` : Start reading from a particular message id, the reader will position itself on that
 	//                  specific position. The first message to be read will be the message next to the specified
 	//                  messageID
+	//
+	// On partitioned topics a specific `MessageID` only positions the partition it belongs to, the other
+	// partitions are read from the earliest message.
 	StartMessageID MessageID
 
 	// If true, the reader will start at the `StartMessageID`, included.
 	// Default is `false` and the reader will start from the "next" message
 	StartMessageIDInclusive bool
 
-	// MessageChannel sets a `MessageChannel` for the consumer
-	// When a message is received, it will be pushed to the channel for consumption
+	// MessageChannel sets a `MessageChannel` for the reader
+	// When a message is received, it will be pushed to the channel for consumption. The channel can be shared
+	// by several readers, it is not closed when the reader is closed and the messages already pushed to it are
+	// kept when the reader seeks.
 	MessageChannel chan ReaderMessage
 
 	// ReceiverQueueSize sets the size of the consumer receive queue.
@@ -79,6 +93,16 @@ type ReaderOptions struct {
 
 	// Decryption decryption related fields to decrypt the encrypted message
 	Decryption *MessageDecryptionInfo
+
+	// AutoDiscoveryPeriod sets the interval at which the reader looks for new partitions of the topics,
+	// and for new topics matching the TopicsPattern. Default is 1 minute.
+	AutoDiscoveryPeriod time.Duration
+
+	// KeyHashRanges restricts the reader to the messages whose key hashes into one of the ranges, given in
+	// value pair list: [x1, x2, y1, y2], bounds included, within [0, 65535]. The hash is the Murmur3 hash of the
+	// ordering key, or else the key, modulo 65536, as for a sticky KeySharedPolicy. The ranges are sent to the
+	// broker and the messages outside of them are also dropped by the reader, for brokers ignoring the ranges.
+	KeyHashRanges []int
 }
 
 // Reader can be used to scan through all the messages currently available in a topic.
@@ -89,6 +113,10 @@ type Reader interface {
 	// Next read the next message in the topic, blocking until a message is available
 	Next(context.Context) (Message, error)
 
+	// Chan returns a channel to consume messages from, it is the `MessageChannel` when one is set in the options.
+	// Otherwise the channel is closed when the reader is closed.
+	Chan() <-chan ReaderMessage
+
 	// HasNext check if there is any message available to read from the current position
 	HasNext() bool
 
@@ -98,14 +126,13 @@ type Reader interface {
 	// Reset the subscription associated with this reader to a specific message id.
 	// The message id can either be a specific message or represent the first or last messages in the topic.
 	//
-	// Note: this operation can only be done on non-partitioned topics. For these, one can rather perform the
-	//       seek() on the individual partitions.
+	// Note: on partitioned topics, seeking to a specific message only resets the partition the message belongs to,
+	//       while seeking to the first or last messages resets every partition.
 	Seek(MessageID) error
 
 	// Reset the subscription associated with this reader to a specific message publish time.
 	//
-	// Note: this operation can only be done on non-partitioned topics. For these, one can rather perform the seek() on
-	// the individual partitions.
+	// Note: on partitioned topics, every partition is reset.
 	//
 	// @param timestamp
 	//            the message publish time where to reposition the subscription
@@ -19,7 +19,6 @@ package pulsar
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"time"
 
@@ -33,19 +32,33 @@ const (
 
 type reader struct {
 	sync.Mutex
-	client              *client
-	pc                  *partitionConsumer
-	messageCh           chan ConsumerMessage
-	lastMessageInBroker trackingMessageID
-	log                 log.Logger
-	metrics             *internal.LeveledMetrics
+	client         *client
+	options        ReaderOptions
+	topic          string
+	startMessageID trackingMessageID
+	// partitioned is true when reading from the partitions of a partitioned topic
+	partitioned bool
+	consumers   []*partitionConsumer
+	messageCh   chan ConsumerMessage
+	// dispatcher is only set on the reader created by the client, not on the readers of a multi-topic reader
+	dispatcher    *readerDispatcher
+	dlq           *dlqRouter
+	stopDiscovery func()
+	closeOnce     sync.Once
+	log           log.Logger
+	metrics       *internal.LeveledMetrics
 }
 
 func newReader(client *client, options ReaderOptions) (Reader, error) {
-	if options.Topic == "" {
+	if options.Topic == "" && len(options.Topics) == 0 && options.TopicsPattern == "" {
 		return nil, newError(InvalidConfiguration, "Topic is required")
 	}
 
+	if (options.Topic != "" && (len(options.Topics) > 0 || options.TopicsPattern != "")) ||
+		(len(options.Topics) > 0 && options.TopicsPattern != "") {
+		return nil, newError(InvalidConfiguration, "only one of Topic, Topics and TopicsPattern can be set")
+	}
+
 	if options.StartMessageID == nil {
 		return nil, newError(InvalidConfiguration, "StartMessageID is required")
 	}
@@ -65,38 +78,45 @@ func newReader(client *client, options ReaderOptions) (Reader, error) {
 		}
 	}
 
-	subscriptionName := options.SubscriptionRolePrefix
-	if subscriptionName == "" {
-		subscriptionName = "reader"
+	if options.SubscriptionRolePrefix == "" {
+		options.SubscriptionRolePrefix = "reader"
 	}
-	subscriptionName += "-" + generateRandomName()
 
-	receiverQueueSize := options.ReceiverQueueSize
-	if receiverQueueSize <= 0 {
-		receiverQueueSize = defaultReceiverQueueSize
+	if options.ReceiverQueueSize <= 0 {
+		options.ReceiverQueueSize = defaultReceiverQueueSize
 	}
 
-	consumerOptions := &partitionConsumerOpts{
-		topic:                      options.Topic,
-		consumerName:               options.Name,
-		subscription:               subscriptionName,
-		subscriptionType:           Exclusive,
-		receiverQueueSize:          receiverQueueSize,
-		startMessageID:             startMessageID,
-		startMessageIDInclusive:    options.StartMessageIDInclusive,
-		subscriptionMode:           nonDurable,
-		readCompacted:              options.ReadCompacted,
-		metadata:                   options.Properties,
-		nackRedeliveryDelay:        defaultNackRedeliveryDelay,
-		replicateSubscriptionState: false,
-		decryption:                 options.Decryption,
+	if len(options.KeyHashRanges) > 0 {
+		if err := validateHashRanges(options.KeyHashRanges); err != nil {
+			return nil, newError(InvalidConfiguration, err.Error())
+		}
 	}
 
+	if options.Topic == "" {
+		return newMultiTopicReader(client, options, startMessageID)
+	}
+
+	messageCh := make(chan ConsumerMessage)
+	reader, err := newTopicReader(client, options, options.Topic, startMessageID, messageCh)
+	if err != nil {
+		close(messageCh)
+		return nil, err
+	}
+	reader.dispatcher = newReaderDispatcher(reader, messageCh, options.MessageChannel, options.KeyHashRanges)
+	return reader, nil
+}
+
+// newTopicReader creates a reader over all the partitions of the topic, the messages are pushed to messageCh
+func newTopicReader(client *client, options ReaderOptions, topic string, startMessageID trackingMessageID,
+	messageCh chan ConsumerMessage) (*reader, error) {
 	reader := &reader{
-		client:    client,
-		messageCh: make(chan ConsumerMessage),
-		log:       client.log.SubLogger(log.Fields{"topic": options.Topic}),
-		metrics:   client.metrics.GetLeveledMetrics(options.Topic),
+		client:         client,
+		options:        options,
+		topic:          topic,
+		startMessageID: startMessageID,
+		messageCh:      messageCh,
+		log:            client.log.SubLogger(log.Fields{"topic": topic}),
+		metrics:        client.metrics.GetLeveledMetrics(topic),
 	}
 
 	// Provide dummy dlq router with not dlq policy
@@ -104,81 +124,397 @@ func newReader(client *client, options ReaderOptions) (Reader, error) {
 	if err != nil {
 		return nil, err
 	}
+	reader.dlq = dlq
 
-	pc, err := newPartitionConsumer(nil, client, consumerOptions, reader.messageCh, dlq, reader.metrics)
-	if err != nil {
-		close(reader.messageCh)
+	if err := reader.internalTopicReadToPartitions(); err != nil {
 		return nil, err
 	}
 
-	reader.pc = pc
+	// set up timer to monitor for new partitions being added
+	duration := options.AutoDiscoveryPeriod
+	if duration <= 0 {
+		duration = defaultAutoDiscoveryDuration
+	}
+	reader.stopDiscovery = reader.runBackgroundPartitionDiscovery(duration)
+
 	reader.metrics.ReadersOpened.Inc()
 	return reader, nil
 }
 
-func (r *reader) Topic() string {
-	return r.pc.topic
+func (r *reader) runBackgroundPartitionDiscovery(period time.Duration) (cancel func()) {
+	var wg sync.WaitGroup
+	stopDiscoveryCh := make(chan struct{})
+	ticker := time.NewTicker(period)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stopDiscoveryCh:
+				return
+			case <-ticker.C:
+				r.log.Debug("Auto discovering new partitions")
+				r.internalTopicReadToPartitions()
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(stopDiscoveryCh)
+		wg.Wait()
+	}
 }
 
-func (r *reader) Next(ctx context.Context) (Message, error) {
-	for {
-		select {
-		case cm, ok := <-r.messageCh:
-			if !ok {
-				return nil, newError(ConsumerClosed, "consumer closed")
+// internalTopicReadToPartitions creates a partition consumer for each partition of the topic
+// that is not read from yet
+func (r *reader) internalTopicReadToPartitions() error {
+	partitions, err := r.client.TopicPartitions(r.topic)
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	oldNumPartitions := len(r.consumers)
+	newNumPartitions := len(partitions)
+	if newNumPartitions <= oldNumPartitions {
+		r.log.Debug("Number of partitions in topic has not changed")
+		return nil
+	}
+
+	tn, err := internal.ParseTopicName(r.topic)
+	if err != nil {
+		return err
+	}
+	// a reader on a single partition of a partitioned topic reads it as a non-partitioned topic,
+	// keeping the partition index in the message ids
+	partitioned := tn.Partition < 0 && partitions[0] != tn.Name
+
+	if r.consumers != nil {
+		r.log.WithField("old_partitions", oldNumPartitions).
+			WithField("new_partitions", newNumPartitions).
+			Info("Changed number of partitions in topic")
+	}
+
+	type consumerError struct {
+		err       error
+		partition int
+		consumer  *partitionConsumer
+	}
+
+	var wg sync.WaitGroup
+	ch := make(chan consumerError, newNumPartitions-oldNumPartitions)
+	wg.Add(newNumPartitions - oldNumPartitions)
+
+	for partitionIdx := oldNumPartitions; partitionIdx < newNumPartitions; partitionIdx++ {
+		// partitions added after the reader was created are read from the beginning
+		startMessageID := trackingMessageID{messageID: earliestMessageID}
+		if oldNumPartitions == 0 {
+			startMessageID = r.partitionStartMessageID(partitioned, partitionIdx)
+		}
+
+		consumerPartitionIdx := partitionIdx
+		if tn.Partition >= 0 {
+			consumerPartitionIdx = tn.Partition
+		}
+
+		go func(idx int, pt string, startMessageID trackingMessageID) {
+			defer wg.Done()
+			pc, err := newPartitionConsumer(nil, r.client,
+				r.partitionConsumerOpts(consumerPartitionIdx, pt, startMessageID),
+				r.messageCh, r.dlq, r.metrics)
+			ch <- consumerError{
+				err:       err,
+				partition: idx,
+				consumer:  pc,
 			}
+		}(partitionIdx, partitions[partitionIdx], startMessageID)
+	}
+
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
 
-			// Acknowledge message immediately because the reader is based on non-durable subscription. When it reconnects,
-			// it will specify the subscription position anyway
-			msgID := cm.Message.ID()
-			if mid, ok := toTrackingMessageID(msgID); ok {
-				r.pc.lastDequeuedMsg = mid
-				r.pc.AckID(mid)
-				return cm.Message, nil
+	consumers := make([]*partitionConsumer, newNumPartitions)
+	copy(consumers, r.consumers)
+	for ce := range ch {
+		if ce.err != nil {
+			err = ce.err
+		} else {
+			consumers[ce.partition] = ce.consumer
+		}
+	}
+
+	if err != nil {
+		// Since there were some failures,
+		// cleanup all the partitions that succeeded in creating the consumer
+		for _, pc := range consumers[oldNumPartitions:] {
+			if pc != nil {
+				pc.Close()
 			}
-			return nil, newError(InvalidMessage, fmt.Sprintf("invalid message id type %T", msgID))
-		case <-ctx.Done():
-			return nil, ctx.Err()
 		}
+		return err
 	}
+
+	r.consumers = consumers
+	r.partitioned = partitioned
+	return nil
 }
 
-func (r *reader) HasNext() bool {
-	if !r.lastMessageInBroker.Undefined() && r.hasMoreMessages() {
-		return true
+// partitionStartMessageID returns the position the given partition is read from: Earliest and Latest apply to
+// every partition while a specific message id only applies to the partition it belongs to, the other partitions
+// being read from the beginning
+func (r *reader) partitionStartMessageID(partitioned bool, partitionIdx int) trackingMessageID {
+	if !partitioned || r.startMessageID.equal(earliestMessageID) || r.startMessageID.equal(latestMessageID) ||
+		int(r.startMessageID.partitionIdx) == partitionIdx {
+		return r.startMessageID
+	}
+	return trackingMessageID{messageID: earliestMessageID}
+}
+
+func (r *reader) partitionConsumerOpts(partitionIdx int, topic string,
+	startMessageID trackingMessageID) *partitionConsumerOpts {
+	opts := &partitionConsumerOpts{
+		topic:                      topic,
+		consumerName:               r.options.Name,
+		subscription:               r.options.SubscriptionRolePrefix + "-" + generateRandomName(),
+		subscriptionType:           Exclusive,
+		partitionIdx:               partitionIdx,
+		receiverQueueSize:          r.options.ReceiverQueueSize,
+		startMessageID:             startMessageID,
+		startMessageIDInclusive:    r.options.StartMessageIDInclusive,
+		subscriptionMode:           nonDurable,
+		readCompacted:              r.options.ReadCompacted,
+		metadata:                   r.options.Properties,
+		nackRedeliveryDelay:        defaultNackRedeliveryDelay,
+		replicateSubscriptionState: false,
+		decryption:                 r.options.Decryption,
+	}
+
+	if len(r.options.KeyHashRanges) > 0 {
+		// the broker only honours the hash ranges of the Key_Shared subscriptions
+		opts.subscriptionType = KeyShared
+		opts.keySharedPolicy = &KeySharedPolicy{
+			Mode:       KeySharedPolicyModeSticky,
+			HashRanges: r.options.KeyHashRanges,
+		}
+	}
+	return opts
+}
+
+func (r *reader) Topic() string {
+	return r.topic
+}
+
+func (r *reader) Next(ctx context.Context) (Message, error) {
+	return r.dispatcher.next(ctx)
+}
+
+func (r *reader) Chan() <-chan ReaderMessage {
+	return r.dispatcher.readerCh
+}
+
+// readerDispatcher hands the messages received by the partition consumers of a reader over to the
+// channel of the reader, acknowledging them as they are handed over since readers use non-durable
+// subscriptions: when reconnecting, the subscription is positioned after the last message handed over.
+type readerDispatcher struct {
+	reader    Reader
+	messageCh chan ConsumerMessage
+	readerCh  chan ReaderMessage
+	// ownCh is true when readerCh was created by the reader rather than given in the options
+	ownCh bool
+	// keyHashRanges, when set, drops the messages whose key hashes outside of the ranges
+	keyHashRanges []int
+
+	pauseCh chan *pauseRequest
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+type pauseRequest struct {
+	// stale returns true for the messages of the partitions being repositioned
+	stale    func(pc *partitionConsumer) bool
+	resumeCh chan struct{}
+}
+
+func newReaderDispatcher(reader Reader, messageCh chan ConsumerMessage,
+	readerCh chan ReaderMessage, keyHashRanges []int) *readerDispatcher {
+	d := &readerDispatcher{
+		reader:        reader,
+		messageCh:     messageCh,
+		readerCh:      readerCh,
+		keyHashRanges: keyHashRanges,
+		pauseCh:       make(chan *pauseRequest),
+		closeCh:       make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	if d.readerCh == nil {
+		d.readerCh = make(chan ReaderMessage)
+		d.ownCh = true
 	}
 
+	go d.run()
+	return d
+}
+
+func (d *readerDispatcher) run() {
+	defer close(d.doneCh)
+
+	var pending *ConsumerMessage
 	for {
-		lastMsgID, err := r.pc.getLastMessageID()
-		if err != nil {
-			r.log.WithError(err).Error("Failed to get last message id from broker")
-			continue
+		// only try to hand the pending message over when there is one
+		var readerCh chan ReaderMessage
+		var messageCh chan ConsumerMessage
+		var rm ReaderMessage
+		if pending != nil {
+			readerCh = d.readerCh
+			rm = ReaderMessage{Reader: d.reader, Message: pending.Message}
 		} else {
-			r.lastMessageInBroker = lastMsgID
-			break
+			messageCh = d.messageCh
 		}
+
+		select {
+		case <-d.closeCh:
+			return
+		case cm := <-messageCh:
+			if len(d.keyHashRanges) > 0 && !keyHashInRanges(cm.Message, d.keyHashRanges) {
+				// the broker ignored the hash ranges
+				d.dequeued(cm.Message)
+				continue
+			}
+			pending = &cm
+		case readerCh <- rm:
+			d.dequeued(pending.Message)
+			pending = nil
+		case req := <-d.pauseCh:
+			if pending != nil {
+				if pc, _, ok := messagePartitionConsumer(pending.Message); ok && req.stale(pc) {
+					pending = nil
+				}
+			}
+			select {
+			case <-req.resumeCh:
+			case <-d.closeCh:
+				return
+			}
+		}
+	}
+}
+
+// dequeued acknowledges the message, which will not be received again when reconnecting
+func (d *readerDispatcher) dequeued(msg Message) {
+	if pc, mid, ok := messagePartitionConsumer(msg); ok {
+		pc.setLastDequeuedMsg(mid)
+		pc.AckID(mid)
+	}
+}
+
+// messagePartitionConsumer returns the partition consumer which received the message
+func messagePartitionConsumer(msg Message) (*partitionConsumer, trackingMessageID, bool) {
+	mid, ok := toTrackingMessageID(msg.ID())
+	if !ok {
+		return nil, trackingMessageID{}, false
 	}
+	pc, ok := mid.consumer.(*partitionConsumer)
+	return pc, mid, ok
+}
 
-	return r.hasMoreMessages()
+// pause stops handing messages over until the returned function is called, the message being
+// handed over is dropped when it is stale. The messages already in a MessageChannel given in the
+// options are left untouched.
+func (d *readerDispatcher) pause(stale func(pc *partitionConsumer) bool) (resume func()) {
+	req := &pauseRequest{
+		stale:    stale,
+		resumeCh: make(chan struct{}),
+	}
+	select {
+	case d.pauseCh <- req:
+	case <-d.doneCh:
+		return func() {}
+	}
+
+	return func() {
+		close(req.resumeCh)
+	}
 }
 
-func (r *reader) hasMoreMessages() bool {
-	if !r.pc.lastDequeuedMsg.Undefined() {
-		return r.lastMessageInBroker.isEntryIDValid() && r.lastMessageInBroker.greater(r.pc.lastDequeuedMsg.messageID)
+func (d *readerDispatcher) next(ctx context.Context) (Message, error) {
+	select {
+	case rm, ok := <-d.readerCh:
+		if !ok {
+			return nil, newError(ConsumerClosed, "consumer closed")
+		}
+		return rm.Message, nil
+	case <-d.doneCh:
+		return nil, newError(ConsumerClosed, "consumer closed")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
+}
 
-	if r.pc.options.startMessageIDInclusive {
-		return r.lastMessageInBroker.isEntryIDValid() && r.lastMessageInBroker.greaterEqual(r.pc.startMessageID.messageID)
+func (d *readerDispatcher) close() {
+	close(d.closeCh)
+	<-d.doneCh
+	if d.ownCh {
+		close(d.readerCh)
 	}
+}
 
-	// Non-inclusive
-	return r.lastMessageInBroker.isEntryIDValid() && r.lastMessageInBroker.greater(r.pc.startMessageID.messageID)
+func (r *reader) HasNext() bool {
+	r.Lock()
+	consumers := r.consumers
+	r.Unlock()
+
+	for _, pc := range consumers {
+		if pc.hasNext() {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *reader) Close() {
-	r.pc.Close()
-	r.client.handlers.Del(r)
-	r.metrics.ReadersClosed.Inc()
+	r.closeOnce.Do(func() {
+		if r.dispatcher != nil {
+			r.dispatcher.close()
+		}
+		r.stopDiscovery()
+
+		r.Lock()
+		defer r.Unlock()
+
+		var wg sync.WaitGroup
+		for _, pc := range r.consumers {
+			wg.Add(1)
+			go func(pc *partitionConsumer) {
+				defer wg.Done()
+				pc.Close()
+			}(pc)
+		}
+		wg.Wait()
+		r.client.handlers.Del(r)
+		r.metrics.ReadersClosed.Inc()
+	})
+}
+
+// owns returns true if the partition consumer reads one of the partitions of this reader
+func (r *reader) owns(pc *partitionConsumer) bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.ownsLocked(pc)
+}
+
+func (r *reader) ownsLocked(pc *partitionConsumer) bool {
+	for _, c := range r.consumers {
+		if c == pc {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *reader) messageID(msgID MessageID) (trackingMessageID, bool) {
@@ -190,29 +526,59 @@ func (r *reader) messageID(msgID MessageID) (trackingMessageID, bool) {
 
 	partition := int(mid.partitionIdx)
 	// did we receive a valid partition index?
-	if partition < 0 {
-		r.log.Warnf("invalid partition index %d expected", partition)
+	if partition < 0 || (r.partitioned && partition >= len(r.consumers)) {
+		r.log.Warnf("invalid partition index %d expected a partition between [0-%d]",
+			partition, len(r.consumers))
 		return trackingMessageID{}, false
 	}
 
 	return mid, true
 }
 
+// Seek resets every partition when given the Earliest or Latest message id, otherwise only
+// the partition of the message id is reset
 func (r *reader) Seek(msgID MessageID) error {
 	r.Lock()
 	defer r.Unlock()
 
+	if mid, ok := toTrackingMessageID(msgID); ok && (mid.equal(earliestMessageID) || mid.equal(latestMessageID)) {
+		if r.dispatcher != nil {
+			defer r.dispatcher.pause(r.ownsLocked)()
+		}
+		for _, pc := range r.consumers {
+			if err := pc.Seek(mid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	mid, ok := r.messageID(msgID)
 	if !ok {
 		return nil
 	}
 
-	return r.pc.Seek(mid)
+	pc := r.consumers[0]
+	if r.partitioned {
+		pc = r.consumers[mid.partitionIdx]
+	}
+	if r.dispatcher != nil {
+		defer r.dispatcher.pause(func(c *partitionConsumer) bool { return c == pc })()
+	}
+	return pc.Seek(mid)
 }
 
 func (r *reader) SeekByTime(time time.Time) error {
 	r.Lock()
 	defer r.Unlock()
 
-	return r.pc.SeekByTime(time)
+	if r.dispatcher != nil {
+		defer r.dispatcher.pause(r.ownsLocked)()
+	}
+	for _, pc := range r.consumers {
+		if err := pc.SeekByTime(time); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,326 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+	"github.com/apache/pulsar-client-go/pulsar/log"
+)
+
+// multiTopicReader reads from a list of topics, or from the topics matching a pattern,
+// merging the messages of all the topics into a single stream.
+type multiTopicReader struct {
+	client *client
+
+	options        ReaderOptions
+	startMessageID trackingMessageID
+	messageCh      chan ConsumerMessage
+	dispatcher     *readerDispatcher
+
+	readersLock sync.Mutex
+	readers     map[string]*reader
+
+	// set when reading from the topics matching a pattern
+	namespace string
+	pattern   *regexp.Regexp
+	mode      RegexSubscriptionMode
+	domain    string
+	ticker    *time.Ticker
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+
+	log log.Logger
+}
+
+func newMultiTopicReader(client *client, options ReaderOptions, startMessageID trackingMessageID) (Reader, error) {
+	if !startMessageID.equal(earliestMessageID) && !startMessageID.equal(latestMessageID) {
+		return nil, newError(InvalidConfiguration,
+			"the StartMessageID of a multi-topic reader must be EarliestMessageID or LatestMessageID")
+	}
+
+	mtr := &multiTopicReader{
+		client:         client,
+		options:        options,
+		startMessageID: startMessageID,
+		messageCh:      make(chan ConsumerMessage),
+		readers:        make(map[string]*reader),
+		closeCh:        make(chan struct{}),
+	}
+
+	var topics []string
+	if options.TopicsPattern != "" {
+		tn, err := internal.ParseTopicName(options.TopicsPattern)
+		if err != nil {
+			return nil, err
+		}
+
+		mtr.pattern, err = extractTopicPattern(tn)
+		if err != nil {
+			return nil, err
+		}
+		mtr.namespace = tn.Namespace
+		mtr.mode = PersistentOnly
+		if hasTopicDomain(options.TopicsPattern) {
+			mtr.domain = tn.Domain
+			if !mtr.mode.includes(tn.Domain) {
+				mtr.mode = NonPersistentOnly
+			}
+		}
+		mtr.log = client.log.SubLogger(log.Fields{"topic": tn.Name})
+
+		topics, err = discoverTopics(client, mtr.namespace, mtr.mode, mtr.domain, mtr.pattern)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tns, err := validateTopicNames(options.Topics...)
+		if err != nil {
+			return nil, err
+		}
+		for _, tn := range tns {
+			topics = append(topics, tn.Name)
+		}
+		topics = distinct(topics)
+		mtr.log = client.log.SubLogger(log.Fields{"topic": topics})
+	}
+
+	if err := mtr.addReaders(topics, startMessageID); err != nil {
+		return nil, err
+	}
+
+	mtr.dispatcher = newReaderDispatcher(mtr, mtr.messageCh, options.MessageChannel, options.KeyHashRanges)
+
+	if mtr.pattern != nil {
+		duration := options.AutoDiscoveryPeriod
+		if duration <= 0 {
+			duration = defaultAutoDiscoveryDuration
+		}
+		mtr.ticker = time.NewTicker(duration)
+		go mtr.monitor()
+	}
+
+	return mtr, nil
+}
+
+// addReaders creates a reader for each of the topics, all the readers are closed on failure
+func (r *multiTopicReader) addReaders(topics []string, startMessageID trackingMessageID) error {
+	type readerError struct {
+		err    error
+		topic  string
+		reader *reader
+	}
+
+	ch := make(chan readerError, len(topics))
+	var wg sync.WaitGroup
+	wg.Add(len(topics))
+	for _, t := range topics {
+		go func(topic string) {
+			defer wg.Done()
+			tr, err := newTopicReader(r.client, r.options, topic, startMessageID, r.messageCh)
+			ch <- readerError{err: err, topic: topic, reader: tr}
+		}(t)
+	}
+	wg.Wait()
+	close(ch)
+
+	var errs error
+	readers := make(map[string]*reader, len(topics))
+	for re := range ch {
+		if re.err != nil {
+			errs = pkgerrors.Wrapf(re.err, "unable to read from topic=%s", re.topic)
+		} else {
+			readers[re.topic] = re.reader
+		}
+	}
+
+	if errs != nil {
+		for _, tr := range readers {
+			tr.Close()
+		}
+		return errs
+	}
+
+	r.readersLock.Lock()
+	defer r.readersLock.Unlock()
+	for t, tr := range readers {
+		r.readers[t] = tr
+	}
+	return nil
+}
+
+func (r *multiTopicReader) monitor() {
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-r.ticker.C:
+			r.log.Debug("Auto discovering topics")
+			r.discover()
+		}
+	}
+}
+
+func (r *multiTopicReader) discover() {
+	topics, err := discoverTopics(r.client, r.namespace, r.mode, r.domain, r.pattern)
+	if err != nil {
+		r.log.WithError(err).Errorf("Failed to discover topics")
+		return
+	}
+
+	known := r.knownTopics()
+	newTopics := topicsDiff(topics, known)
+	staleTopics := topicsDiff(known, topics)
+
+	r.log.
+		WithFields(log.Fields{
+			"new_topics": newTopics,
+			"old_topics": staleTopics,
+		}).
+		Debug("discover topics")
+
+	r.readersLock.Lock()
+	for _, t := range staleTopics {
+		if tr, ok := r.readers[t]; ok {
+			tr.Close()
+			delete(r.readers, t)
+		}
+	}
+	r.readersLock.Unlock()
+
+	// topics created after the reader are read from the beginning
+	if err := r.addReaders(newTopics, trackingMessageID{messageID: earliestMessageID}); err != nil {
+		r.log.WithError(err).Warn("Failed to read from the new topics")
+	}
+}
+
+func (r *multiTopicReader) knownTopics() []string {
+	r.readersLock.Lock()
+	defer r.readersLock.Unlock()
+	topics := make([]string, 0, len(r.readers))
+	for t := range r.readers {
+		topics = append(topics, t)
+	}
+	return topics
+}
+
+func (r *multiTopicReader) currentReaders() []*reader {
+	r.readersLock.Lock()
+	defer r.readersLock.Unlock()
+	readers := make([]*reader, 0, len(r.readers))
+	for _, tr := range r.readers {
+		readers = append(readers, tr)
+	}
+	return readers
+}
+
+// Topic returns the pattern of the topics read from, or their comma separated list
+func (r *multiTopicReader) Topic() string {
+	if r.options.TopicsPattern != "" {
+		return r.options.TopicsPattern
+	}
+	return strings.Join(r.knownTopics(), ",")
+}
+
+func (r *multiTopicReader) Next(ctx context.Context) (Message, error) {
+	return r.dispatcher.next(ctx)
+}
+
+func (r *multiTopicReader) Chan() <-chan ReaderMessage {
+	return r.dispatcher.readerCh
+}
+
+func (r *multiTopicReader) HasNext() bool {
+	for _, tr := range r.currentReaders() {
+		if tr.HasNext() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *multiTopicReader) Close() {
+	r.closeOnce.Do(func() {
+		if r.ticker != nil {
+			r.ticker.Stop()
+		}
+		close(r.closeCh)
+		r.dispatcher.close()
+
+		var wg sync.WaitGroup
+		for _, tr := range r.currentReaders() {
+			wg.Add(1)
+			go func(tr *reader) {
+				defer wg.Done()
+				tr.Close()
+			}(tr)
+		}
+		wg.Wait()
+		r.client.handlers.Del(r)
+	})
+}
+
+// Seek resets every topic to the Earliest or Latest message id, a specific message id only resets
+// the topic the message was read from
+func (r *multiTopicReader) Seek(msgID MessageID) error {
+	mid, ok := toTrackingMessageID(msgID)
+	if !ok {
+		return newError(SeekFailed, "invalid message id type")
+	}
+
+	if mid.equal(earliestMessageID) || mid.equal(latestMessageID) {
+		defer r.dispatcher.pause(func(*partitionConsumer) bool { return true })()
+		for _, tr := range r.currentReaders() {
+			if err := tr.Seek(mid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	pc, ok := mid.consumer.(*partitionConsumer)
+	if !ok {
+		return newError(SeekFailed, "for multi-topic reader, seek needs a message id returned by the reader")
+	}
+
+	for _, tr := range r.currentReaders() {
+		if tr.owns(pc) {
+			defer r.dispatcher.pause(tr.owns)()
+			return tr.Seek(mid)
+		}
+	}
+	return newError(SeekFailed, "the topic of the message id is not read from anymore")
+}
+
+func (r *multiTopicReader) SeekByTime(time time.Time) error {
+	defer r.dispatcher.pause(func(*partitionConsumer) bool { return true })()
+	for _, tr := range r.currentReaders() {
+		if err := tr.SeekByTime(time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+)
+
+// RangePosition is a position in a topic bounding a range read, either a message id or a publish time.
+// The zero value leaves the range unbounded on that side.
+type RangePosition struct {
+	// MessageID of the position. On partitioned topics it only bounds the partition the message belongs to.
+	MessageID MessageID
+
+	// PublishTime of the position, used when MessageID is not set.
+	PublishTime time.Time
+}
+
+// RangeOptions contains the options of a range read
+type RangeOptions struct {
+	// Topic to read from, it can be partitioned.
+	// This argument is required.
+	Topic string
+
+	// Start of the range, the range starts at the earliest message when not set.
+	// A start publish time includes the messages published at that time.
+	Start RangePosition
+
+	// End of the range, the range ends at the last message of the topic when the read starts when not set.
+	// The messages published afterwards are never read.
+	End RangePosition
+
+	// Inclusive includes the messages at the start message id and at the end message id or publish time in the range.
+	Inclusive bool
+
+	// ReceiverQueueSize sets the size of the receive queue of each partition, see ReaderOptions.
+	ReceiverQueueSize int
+
+	// Decryption decryption related fields to decrypt the encrypted message
+	Decryption *MessageDecryptionInfo
+}
+
+func (c *client) ReadRange(ctx context.Context, options RangeOptions, handler func(Message) error) error {
+	if options.Topic == "" {
+		return newError(InvalidConfiguration, "Topic is required")
+	}
+
+	tn, err := internal.ParseTopicName(options.Topic)
+	if err != nil {
+		return err
+	}
+
+	partitions, err := c.TopicPartitions(options.Topic)
+	if err != nil {
+		return err
+	}
+	// reading a single partition of a partitioned topic is the same as reading a non-partitioned topic
+	partitioned := tn.Partition < 0 && len(partitions) > 0 && partitions[0] != tn.Name
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	msgCh := make(chan Message)
+	errCh := make(chan error, len(partitions))
+	var wg sync.WaitGroup
+	wg.Add(len(partitions))
+	for idx, partition := range partitions {
+		go func(idx int, partition string) {
+			defer wg.Done()
+			rr := &rangeReader{
+				client:       c,
+				options:      options,
+				topic:        partition,
+				partitionIdx: idx,
+				partitioned:  partitioned,
+			}
+			errCh <- rr.read(ctx, msgCh)
+		}(idx, partition)
+	}
+	go func() {
+		wg.Wait()
+		close(msgCh)
+		close(errCh)
+	}()
+
+	for msg := range msgCh {
+		if err := handler(msg); err != nil {
+			// stop the partition readers and wait for them to be closed
+			cancel()
+			for range msgCh {
+			}
+			return err
+		}
+	}
+
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rangeReader reads the messages of a range from a single partition
+type rangeReader struct {
+	client       *client
+	options      RangeOptions
+	topic        string
+	partitionIdx int
+	partitioned  bool
+}
+
+// bounds returns the position bounding this partition, if any
+func (rr *rangeReader) bounds(p RangePosition) (trackingMessageID, bool) {
+	if p.MessageID == nil {
+		return trackingMessageID{}, false
+	}
+
+	mid, ok := toTrackingMessageID(p.MessageID)
+	if !ok {
+		return trackingMessageID{}, false
+	}
+	if rr.partitioned && int(mid.partitionIdx) != rr.partitionIdx {
+		return trackingMessageID{}, false
+	}
+	return mid, true
+}
+
+func (rr *rangeReader) newReader(startMessageID MessageID, inclusive bool) (*reader, error) {
+	r, err := newReader(rr.client, ReaderOptions{
+		Topic:                   rr.topic,
+		StartMessageID:          startMessageID,
+		StartMessageIDInclusive: inclusive,
+		ReceiverQueueSize:       rr.options.ReceiverQueueSize,
+		Decryption:              rr.options.Decryption,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.(*reader), nil
+}
+
+func (rr *rangeReader) read(ctx context.Context, msgCh chan<- Message) error {
+	startMessageID := EarliestMessageID()
+	startInclusive := true
+	if mid, ok := rr.bounds(rr.options.Start); ok {
+		startMessageID = mid
+		startInclusive = rr.options.Inclusive
+	}
+
+	r, err := rr.newReader(startMessageID, startInclusive)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// the end of the range is never after the last message at the time the read starts
+	last, err := r.consumers[0].getLastMessageID()
+	if err != nil {
+		return err
+	}
+	if !last.isEntryIDValid() {
+		// empty partition
+		return nil
+	}
+
+	end := last.messageID
+	endInclusive := true
+	if mid, ok := rr.bounds(rr.options.End); ok && !mid.greater(end) {
+		end = mid.messageID
+		endInclusive = rr.options.Inclusive
+	}
+
+	if start, ok := rr.bounds(rr.options.Start); ok {
+		if start.greater(end) || (start.equal(end) && !(startInclusive && endInclusive)) {
+			return nil
+		}
+	}
+
+	startTime := rr.options.Start.PublishTime
+	if rr.options.Start.MessageID == nil && !startTime.IsZero() {
+		// seeking by time would wait forever for a message when they are all older than the start
+		lastPublishTime, err := rr.lastPublishTime(ctx, last)
+		if err != nil {
+			return err
+		}
+		if lastPublishTime.Before(startTime) {
+			return nil
+		}
+		if err := r.SeekByTime(startTime); err != nil {
+			return err
+		}
+	}
+
+	endTime := rr.options.End.PublishTime
+	if rr.options.End.MessageID != nil {
+		endTime = time.Time{}
+	}
+
+	for {
+		msg, err := r.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !startTime.IsZero() && msg.PublishTime().Before(startTime) {
+			continue
+		}
+		if !endTime.IsZero() && (msg.PublishTime().After(endTime) ||
+			(!rr.options.Inclusive && msg.PublishTime().Equal(endTime))) {
+			return nil
+		}
+
+		mid, ok := toTrackingMessageID(msg.ID())
+		if !ok {
+			return newError(InvalidMessage, "invalid message id type")
+		}
+
+		deliver, done := rangeEndReached(mid, end, endInclusive)
+		if deliver {
+			select {
+			case msgCh <- msg:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// lastPublishTime returns the publish time of the last message of the partition
+func (rr *rangeReader) lastPublishTime(ctx context.Context, last trackingMessageID) (time.Time, error) {
+	r, err := rr.newReader(last, true)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer r.Close()
+
+	msg, err := r.Next(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return msg.PublishTime(), nil
+}
+
+// rangeEndReached returns whether the message is in a range ending at end, and whether it is the last
+// message of the range. An end without batch index covers all the messages of its batch.
+func rangeEndReached(mid trackingMessageID, end messageID, inclusive bool) (deliver bool, done bool) {
+	if mid.ledgerID != end.ledgerID || mid.entryID != end.entryID {
+		if mid.ledgerID > end.ledgerID || (mid.ledgerID == end.ledgerID && mid.entryID > end.entryID) {
+			return false, true
+		}
+		return true, false
+	}
+
+	if end.batchIdx >= 0 && mid.batchIdx >= 0 {
+		switch {
+		case mid.batchIdx < end.batchIdx:
+			return true, false
+		case mid.batchIdx == end.batchIdx:
+			return inclusive, true
+		default:
+			return false, true
+		}
+	}
+
+	lastInBatch := mid.tracker == nil || mid.batchIdx < 0 || int(mid.batchIdx) == mid.tracker.size-1
+	return inclusive, lastInBatch
+}
@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeEndReached(t *testing.T) {
+	end := messageID{ledgerID: 10, entryID: 5, batchIdx: -1}
+	batch := &ackTracker{size: 3}
+
+	tests := []struct {
+		name      string
+		mid       trackingMessageID
+		end       messageID
+		inclusive bool
+		deliver   bool
+		done      bool
+	}{
+		{"before", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 4, batchIdx: -1}}, end, true, true, false},
+		{"previous ledger", trackingMessageID{messageID: messageID{ledgerID: 9, entryID: 7, batchIdx: -1}}, end, true,
+			true, false},
+		{"after", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 6, batchIdx: -1}}, end, true, false, true},
+		{"at end", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: -1}}, end, true, true, true},
+		{"at end exclusive", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: -1}}, end, false,
+			false, true},
+		{"in end batch", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: 1}, tracker: batch},
+			end, true, true, false},
+		{"last of end batch", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: 2},
+			tracker: batch}, end, true, true, true},
+		{"before end batch index", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: 0}},
+			messageID{ledgerID: 10, entryID: 5, batchIdx: 1}, true, true, false},
+		{"at end batch index", trackingMessageID{messageID: messageID{ledgerID: 10, entryID: 5, batchIdx: 1}},
+			messageID{ledgerID: 10, entryID: 5, batchIdx: 1}, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliver, done := rangeEndReached(tt.mid, tt.end, tt.inclusive)
+			assert.Equal(t, tt.deliver, deliver)
+			assert.Equal(t, tt.done, done)
+		})
+	}
+}
+
+func TestReadRange(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	testURL := adminURL + "/" + "admin/v2/persistent/public/default/" + topic + "/partitions"
+	makeHTTPCall(t, http.MethodPut, testURL, "2")
+	ctx := context.Background()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: topic,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	send := func(from, to int) {
+		for i := from; i < to; i++ {
+			_, err := producer.Send(ctx, &ProducerMessage{
+				Key:     fmt.Sprintf("key-%d", i),
+				Payload: []byte(fmt.Sprintf("hello-%d", i)),
+			})
+			assert.NoError(t, err)
+		}
+	}
+
+	send(0, 10)
+	time.Sleep(100 * time.Millisecond)
+	start := time.Now()
+	send(10, 20)
+	time.Sleep(100 * time.Millisecond)
+	end := time.Now()
+	send(20, 30)
+
+	read := func(options RangeOptions) map[string]bool {
+		received := make(map[string]bool)
+		err := client.ReadRange(ctx, options, func(msg Message) error {
+			received[string(msg.Payload())] = true
+			return nil
+		})
+		assert.Nil(t, err)
+		return received
+	}
+
+	// the whole topic
+	assert.Equal(t, 30, len(read(RangeOptions{Topic: topic})))
+
+	// between two publish times
+	received := read(RangeOptions{
+		Topic: topic,
+		Start: RangePosition{PublishTime: start},
+		End:   RangePosition{PublishTime: end},
+	})
+	assert.Equal(t, 10, len(received))
+	for i := 10; i < 20; i++ {
+		assert.True(t, received[fmt.Sprintf("hello-%d", i)])
+	}
+
+	// nothing is published after the start
+	assert.Equal(t, 0, len(read(RangeOptions{
+		Topic: topic,
+		Start: RangePosition{PublishTime: time.Now().Add(time.Hour)},
+	})))
+
+	// the handler error stops the read
+	handlerErr := errors.New("stop")
+	err = client.ReadRange(ctx, RangeOptions{Topic: topic}, func(msg Message) error {
+		return handlerErr
+	})
+	assert.Equal(t, handlerErr, err)
+}
+
+func TestReadRangeMessageIDs(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:           topic,
+		DisableBatching: true,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	ids := make([]MessageID, 10)
+	for i := 0; i < 10; i++ {
+		ids[i], err = producer.Send(ctx, &ProducerMessage{
+			Payload: []byte(fmt.Sprintf("hello-%d", i)),
+		})
+		assert.NoError(t, err)
+	}
+
+	var payloads []string
+	err = client.ReadRange(ctx, RangeOptions{
+		Topic:     topic,
+		Start:     RangePosition{MessageID: ids[2]},
+		End:       RangePosition{MessageID: ids[5]},
+		Inclusive: true,
+	}, func(msg Message) error {
+		payloads = append(payloads, string(msg.Payload()))
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"hello-2", "hello-3", "hello-4", "hello-5"}, payloads)
+}
@@ -20,10 +20,12 @@ package pulsar
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar/crypto"
+	"github.com/apache/pulsar-client-go/pulsar/internal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -710,3 +712,348 @@ func TestProducerReaderRSAEncryption(t *testing.T) {
 		assert.Equal(t, []byte(expectMsg), msg.Payload())
 	}
 }
+
+func TestReaderOnPartitionedTopic(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	testURL := adminURL + "/" + "admin/v2/persistent/public/default/" + topic + "/partitions"
+	makeHTTPCall(t, http.MethodPut, testURL, "3")
+	ctx := context.Background()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:           topic,
+		DisableBatching: true,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	const N = 30
+	for i := 0; i < N; i++ {
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Key:     fmt.Sprintf("key-%d", i),
+			Payload: []byte(fmt.Sprintf("hello-%d", i)),
+		})
+		assert.NoError(t, err)
+	}
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: EarliestMessageID(),
+	})
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	received := make(map[string]bool)
+	partitions := make(map[int32]bool)
+	for reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		assert.NoError(t, err)
+		received[string(msg.Payload())] = true
+		partitions[msg.ID().PartitionIdx()] = true
+	}
+	assert.Equal(t, N, len(received))
+	assert.Equal(t, 3, len(partitions))
+
+	// seeking to the earliest message resets every partition
+	err = reader.Seek(EarliestMessageID())
+	assert.Nil(t, err)
+
+	received = make(map[string]bool)
+	for i := 0; i < N; i++ {
+		msg, err := reader.Next(ctx)
+		assert.NoError(t, err)
+		received[string(msg.Payload())] = true
+	}
+	assert.Equal(t, N, len(received))
+}
+
+func TestMultiTopicReaderConfigErrors(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:          "my-topic",
+		Topics:         []string{"my-topic-1", "my-topic-2"},
+		StartMessageID: EarliestMessageID(),
+	})
+	assert.Nil(t, reader)
+	assert.NotNil(t, err)
+
+	reader, err = client.CreateReader(ReaderOptions{
+		Topics:         []string{"my-topic-1", "my-topic-2"},
+		StartMessageID: newMessageID(1, 1, -1, 0),
+	})
+	assert.Nil(t, reader)
+	assert.NotNil(t, err)
+}
+
+func TestMultiTopicReader(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topics := []string{newTopicName(), newTopicName()}
+	ctx := context.Background()
+
+	const N = 10
+	for _, topic := range topics {
+		producer, err := client.CreateProducer(ProducerOptions{
+			Topic: topic,
+		})
+		assert.Nil(t, err)
+
+		for i := 0; i < N; i++ {
+			_, err := producer.Send(ctx, &ProducerMessage{
+				Payload: []byte(fmt.Sprintf("%s-%d", topic, i)),
+			})
+			assert.NoError(t, err)
+		}
+		producer.Close()
+	}
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topics:         topics,
+		StartMessageID: EarliestMessageID(),
+	})
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	received := make(map[string]bool)
+	for reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		assert.NoError(t, err)
+		received[string(msg.Payload())] = true
+	}
+	assert.Equal(t, 2*N, len(received))
+
+	// seek every topic back to its beginning
+	err = reader.SeekByTime(time.Unix(0, 0))
+	assert.Nil(t, err)
+
+	received = make(map[string]bool)
+	for i := 0; i < 2*N; i++ {
+		msg, err := reader.Next(ctx)
+		assert.NoError(t, err)
+		received[string(msg.Payload())] = true
+	}
+	assert.Equal(t, 2*N, len(received))
+}
+
+func TestPatternReader(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	prefix := fmt.Sprintf("orders-%d-", time.Now().UnixNano())
+	ctx := context.Background()
+
+	send := func(topic string) {
+		producer, err := client.CreateProducer(ProducerOptions{
+			Topic: topic,
+		})
+		assert.Nil(t, err)
+		defer producer.Close()
+
+		_, err = producer.Send(ctx, &ProducerMessage{
+			Payload: []byte(topic),
+		})
+		assert.NoError(t, err)
+	}
+
+	send("persistent://public/default/" + prefix + "eu")
+	send("persistent://public/default/" + prefix + "us")
+	send("persistent://public/default/other-" + prefix)
+
+	reader, err := client.CreateReader(ReaderOptions{
+		TopicsPattern:       "persistent://public/default/" + prefix + ".*",
+		StartMessageID:      EarliestMessageID(),
+		AutoDiscoveryPeriod: 2 * time.Second,
+	})
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	received := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		msg, err := reader.Next(ctx)
+		assert.NoError(t, err)
+		received[string(msg.Payload())] = true
+	}
+	assert.True(t, received["persistent://public/default/"+prefix+"eu"])
+	assert.True(t, received["persistent://public/default/"+prefix+"us"])
+
+	// topics created afterwards are discovered and read from the beginning
+	send("persistent://public/default/" + prefix + "asia")
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	msg, err := reader.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "persistent://public/default/"+prefix+"asia", string(msg.Payload()))
+}
+
+func TestReaderDispatcherDropsStaleMessageOnPause(t *testing.T) {
+	messageCh := make(chan ConsumerMessage)
+	d := newReaderDispatcher(nil, messageCh, nil, nil)
+	defer d.close()
+
+	pc := &partitionConsumer{}
+	stale := &message{
+		payLoad: []byte("stale"),
+		msgID:   trackingMessageID{messageID: messageID{ledgerID: 1}, consumer: pc},
+	}
+	fresh := &message{
+		payLoad: []byte("fresh"),
+		msgID:   trackingMessageID{messageID: messageID{ledgerID: 2}},
+	}
+
+	messageCh <- ConsumerMessage{Message: stale}
+	resume := d.pause(func(c *partitionConsumer) bool { return c == pc })
+	resume()
+	messageCh <- ConsumerMessage{Message: fresh}
+
+	msg, err := d.next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "fresh", string(msg.Payload()))
+}
+
+func TestReaderChan(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: topic,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	const N = 10
+	for i := 0; i < N; i++ {
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Payload: []byte(fmt.Sprintf("hello-%d", i)),
+		})
+		assert.NoError(t, err)
+	}
+
+	messageCh := make(chan ReaderMessage, N)
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: EarliestMessageID(),
+		MessageChannel: messageCh,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, (<-chan ReaderMessage)(messageCh), reader.Chan())
+
+	for i := 0; i < N; i++ {
+		rm := <-reader.Chan()
+		assert.Equal(t, reader, rm.Reader)
+		assert.Equal(t, fmt.Sprintf("hello-%d", i), string(rm.Payload()))
+	}
+	assert.False(t, reader.HasNext())
+
+	// the messages are delivered again after seeking
+	err = reader.Seek(EarliestMessageID())
+	assert.Nil(t, err)
+	rm := <-reader.Chan()
+	assert.Equal(t, "hello-0", string(rm.Payload()))
+
+	reader.Close()
+
+	// the channel of the reader is closed with it
+	reader, err = client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: EarliestMessageID(),
+	})
+	assert.Nil(t, err)
+	rm = <-reader.Chan()
+	assert.Equal(t, "hello-0", string(rm.Payload()))
+	reader.Close()
+
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	for {
+		select {
+		case _, ok := <-reader.Chan():
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			t.Fatal("reader channel not closed")
+		}
+	}
+}
+
+func TestReaderKeyHashRanges(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	ctx := context.Background()
+
+	_, err = client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: EarliestMessageID(),
+		KeyHashRanges:  []int{0, 100, 50, 200},
+	})
+	assert.NotNil(t, err)
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:          topic,
+		StartMessageID: EarliestMessageID(),
+		KeyHashRanges:  []int{0, 32767},
+	})
+	assert.Nil(t, err)
+	defer reader.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: topic,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	expected := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Key:     key,
+			Payload: []byte(key),
+		})
+		assert.NoError(t, err)
+		if internal.Murmur3_32Hash(key)%keyHashRangeSize <= 32767 {
+			expected[key] = true
+		}
+	}
+
+	received := make(map[string]bool)
+	for len(received) < len(expected) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		msg, err := reader.Next(ctx)
+		cancel()
+		if !assert.NoError(t, err) {
+			break
+		}
+		assert.True(t, expected[msg.Key()], "unexpected key %s", msg.Key())
+		received[msg.Key()] = true
+	}
+	assert.Equal(t, expected, received)
+}
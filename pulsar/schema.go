@@ -19,8 +19,12 @@ package pulsar
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"sync"
+	"time"
 	"unsafe"
 
 	log "github.com/sirupsen/logrus"
@@ -32,28 +36,41 @@ import (
 type SchemaType int
 
 const (
-	NONE        SchemaType = iota //No schema defined
-	STRING                        //Simple String encoding with UTF-8
-	JSON                          //JSON object encoding and validation
-	PROTOBUF                      //Protobuf message encoding and decoding
-	AVRO                          //Serialize and deserialize via Avro
-	BOOLEAN                       //
-	INT8                          //A 8-byte integer.
-	INT16                         //A 16-byte integer.
-	INT32                         //A 32-byte integer.
-	INT64                         //A 64-byte integer.
-	FLOAT                         //A float number.
-	DOUBLE                        //A double number
-	_                             //
-	_                             //
-	_                             //
-	KeyValue                      //A Schema that contains Key Schema and Value Schema.
-	BYTES       = -1              //A bytes array.
-	AUTO        = -2              //
-	AutoConsume = -3              //Auto Consume Type.
-	AutoPublish = -4              // Auto Publish Type.
+	NONE          SchemaType = iota //No schema defined
+	STRING                          //Simple String encoding with UTF-8
+	JSON                            //JSON object encoding and validation
+	PROTOBUF                        //Protobuf message encoding and decoding
+	AVRO                            //Serialize and deserialize via Avro
+	BOOLEAN                         //
+	INT8                            //A 8-byte integer.
+	INT16                           //A 16-byte integer.
+	INT32                           //A 32-byte integer.
+	INT64                           //A 64-byte integer.
+	FLOAT                           //A float number.
+	DOUBLE                          //A double number
+	DATE                            //A date, in milliseconds since the epoch.
+	TIME                            //A time, in milliseconds since the epoch.
+	TIMESTAMP                       //A timestamp, in milliseconds since the epoch.
+	KeyValue                        //A Schema that contains Key Schema and Value Schema.
+	INSTANT                         //An instant, in seconds and nanoseconds since the epoch.
+	LocalDate                       //A date without time zone, in days since the epoch.
+	LocalTime                       //A time of day without time zone, in nanoseconds since midnight.
+	LocalDateTime                   //A date and time without time zone.
+	ProtoNative                     //Protobuf message encoding and decoding with the message descriptors.
+	BYTES         = -1              //A bytes array.
+	AUTO          = -2              //
+	AutoConsume   = -3              //Auto Consume Type.
+	AutoPublish   = -4              // Auto Publish Type.
 )
 
+// String returns the name of the schema type, as in the Java client
+func (t SchemaType) String() string {
+	if name, ok := schemaTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("SchemaType(%d)", int(t))
+}
+
 // Encapsulates data around the schema definition
 type SchemaInfo struct {
 	Name       string
@@ -69,6 +86,12 @@ type Schema interface {
 	GetSchemaInfo() *SchemaInfo
 }
 
+// messageDecoder is implemented by the schemas which decode the value of a message with more than its payload,
+// eg. with its key or with the schema it was produced with
+type messageDecoder interface {
+	decodeMessage(msg *message, v interface{}) error
+}
+
 type AvroCodec struct {
 	Codec *goavro.Codec
 }
@@ -89,6 +112,7 @@ func initAvroCodec(codec string) (*goavro.Codec, error) {
 type JSONSchema struct {
 	AvroCodec
 	SchemaInfo
+	validator *jsonValidator
 }
 
 func NewJSONSchema(jsonAvroSchemaDef string, properties map[string]string) *JSONSchema {
@@ -102,6 +126,10 @@ func NewJSONSchema(jsonAvroSchemaDef string, properties map[string]string) *JSON
 	js.SchemaInfo.Type = JSON
 	js.SchemaInfo.Properties = properties
 	js.SchemaInfo.Name = "JSON"
+	js.validator, err = newJSONValidator(js.SchemaInfo.Schema)
+	if err != nil {
+		log.Fatalf("parse the JSON schema definition error:%v", err)
+	}
 	return js
 }
 
@@ -113,8 +141,13 @@ func (js *JSONSchema) Decode(data []byte, v interface{}) error {
 	return json.Unmarshal(data, v)
 }
 
+// Validate checks that the message is a JSON document matching the record definition of the schema: the
+// fields without default must be present, and the values must match the types of the fields.
 func (js *JSONSchema) Validate(message []byte) error {
-	return js.Decode(message, nil)
+	if err := js.validator.validate(message); err != nil {
+		return newError(InvalidMessage, fmt.Sprintf("invalid JSON payload: %v", err))
+	}
+	return nil
 }
 
 func (js *JSONSchema) GetSchemaInfo() *SchemaInfo {
@@ -160,6 +193,15 @@ func (ps *ProtoSchema) GetSchemaInfo() *SchemaInfo {
 type AvroSchema struct {
 	AvroCodec
 	SchemaInfo
+
+	// the writer schemas of the messages, by definition, along with the parsed schema of the AvroSchema
+	writersLock sync.Mutex
+	writers     map[string]*avroWriterSchema
+	reader      *avroWriterSchema
+
+	// set when the schema is generated from a struct, whose values are converted without encoding/json
+	structType  reflect.Type
+	structCodec *structCodec
 }
 
 func NewAvroSchema(avroSchemaDef string, properties map[string]string) *AvroSchema {
@@ -178,6 +220,9 @@ func NewAvroSchema(avroSchemaDef string, properties map[string]string) *AvroSche
 }
 
 func (as *AvroSchema) Encode(data interface{}) ([]byte, error) {
+	if v, ok := as.structValue(data); ok {
+		return as.Codec.BinaryFromNative(nil, as.structCodec.native(v))
+	}
 	textual, err := json.Marshal(data)
 	if err != nil {
 		log.Errorf("serialize data error:%s", err.Error())
@@ -197,6 +242,14 @@ func (as *AvroSchema) Decode(data []byte, v interface{}) error {
 		log.Errorf("convert binary Avro data back to native Go form error:%s", err.Error())
 		return err
 	}
+	return as.fromNative(native, v)
+}
+
+// fromNative sets v from the goavro native form of the data
+func (as *AvroSchema) fromNative(native interface{}, v interface{}) error {
+	if sv, ok := as.structValue(v); ok && sv.CanSet() {
+		return as.structCodec.fromNative(native, sv)
+	}
 	textual, err := as.Codec.TextualFromNative(nil, native)
 	if err != nil {
 		log.Errorf("convert native Go form to textual Avro data error:%s", err.Error())
@@ -210,8 +263,34 @@ func (as *AvroSchema) Decode(data []byte, v interface{}) error {
 	return nil
 }
 
+// structValue returns the struct the schema was generated from, pointed to by v
+func (as *AvroSchema) structValue(v interface{}) (reflect.Value, bool) {
+	if as.structCodec == nil {
+		return reflect.Value{}, false
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Type().Elem() != as.structType {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return rv.Elem(), true
+	}
+	if rv.IsValid() && rv.Type() == as.structType {
+		return rv, true
+	}
+	return reflect.Value{}, false
+}
+
+// Validate checks that the message is the binary encoding of a record of the schema, without trailing bytes
 func (as *AvroSchema) Validate(message []byte) error {
-	return as.Decode(message, nil)
+	_, rest, err := as.Codec.NativeFromBinary(message)
+	if err == nil && len(rest) > 0 {
+		err = fmt.Errorf("%d trailing bytes", len(rest))
+	}
+	if err != nil {
+		return newError(InvalidMessage, fmt.Sprintf("invalid Avro payload: %v", err))
+	}
+	return nil
 }
 
 func (as *AvroSchema) GetSchemaInfo() *SchemaInfo {
@@ -499,3 +578,196 @@ func (ds *DoubleSchema) Validate(message []byte) error {
 func (ds *DoubleSchema) GetSchemaInfo() *SchemaInfo {
 	return &ds.SchemaInfo
 }
+
+type BooleanSchema struct {
+	SchemaInfo
+}
+
+func NewBooleanSchema(properties map[string]string) *BooleanSchema {
+	booleanSchema := new(BooleanSchema)
+	booleanSchema.SchemaInfo.Properties = properties
+	booleanSchema.SchemaInfo.Name = "BOOLEAN"
+	booleanSchema.SchemaInfo.Type = BOOLEAN
+	booleanSchema.SchemaInfo.Schema = ""
+	return booleanSchema
+}
+
+// Encode encodes a bool as a single byte, 1 for true and 0 for false
+func (bs *BooleanSchema) Encode(value interface{}) ([]byte, error) {
+	b, ok := value.(bool)
+	if !ok {
+		return nil, newError(InvalidMessage, fmt.Sprintf("BooleanSchema cannot encode %T", value))
+	}
+	if b {
+		return []byte{1}, nil
+	}
+	return []byte{0}, nil
+}
+
+func (bs *BooleanSchema) Decode(data []byte, v interface{}) error {
+	if err := bs.Validate(data); err != nil {
+		return err
+	}
+	b, ok := v.(*bool)
+	if !ok {
+		return newError(InvalidMessage, fmt.Sprintf("BooleanSchema cannot decode into %T", v))
+	}
+	*b = data[0] != 0
+	return nil
+}
+
+func (bs *BooleanSchema) Validate(message []byte) error {
+	if len(message) != 1 {
+		return newError(InvalidMessage, "size of data received by BooleanSchema is not 1")
+	}
+	return nil
+}
+
+func (bs *BooleanSchema) GetSchemaInfo() *SchemaInfo {
+	return &bs.SchemaInfo
+}
+
+// TimeSchema encodes a time.Time as the Java DATE, TIME, TIMESTAMP, INSTANT, LOCAL_DATE, LOCAL_TIME and
+// LOCAL_DATE_TIME schemas do, the values are decoded into a *time.Time.
+type TimeSchema struct {
+	SchemaInfo
+}
+
+func newTimeSchema(schemaType SchemaType, name string, properties map[string]string) *TimeSchema {
+	timeSchema := new(TimeSchema)
+	timeSchema.SchemaInfo.Properties = properties
+	timeSchema.SchemaInfo.Name = name
+	timeSchema.SchemaInfo.Type = schemaType
+	timeSchema.SchemaInfo.Schema = ""
+	return timeSchema
+}
+
+// NewDateSchema creates the schema of the dates, encoded in milliseconds since the epoch
+func NewDateSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(DATE, "DATE", properties)
+}
+
+// NewTimeSchema creates the schema of the times, encoded in milliseconds since the epoch
+func NewTimeSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(TIME, "TIME", properties)
+}
+
+// NewTimestampSchema creates the schema of the timestamps, encoded in milliseconds since the epoch
+func NewTimestampSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(TIMESTAMP, "TIMESTAMP", properties)
+}
+
+// NewInstantSchema creates the schema of the instants, encoded in seconds since the epoch followed by
+// the nanoseconds within the second
+func NewInstantSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(INSTANT, "INSTANT", properties)
+}
+
+// NewLocalDateSchema creates the schema of the dates without time zone, encoded in days since the epoch.
+// The dates are decoded at midnight UTC.
+func NewLocalDateSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(LocalDate, "LOCAL_DATE", properties)
+}
+
+// NewLocalTimeSchema creates the schema of the times of day without time zone, encoded in nanoseconds since
+// midnight. The times are decoded on January 1, year 1 UTC, the date of the zero time.Time.
+func NewLocalTimeSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(LocalTime, "LOCAL_TIME", properties)
+}
+
+// NewLocalDateTimeSchema creates the schema of the dates and times without time zone, encoded in days since the
+// epoch followed by the nanoseconds since midnight. The values are decoded in UTC.
+func NewLocalDateTimeSchema(properties map[string]string) *TimeSchema {
+	return newTimeSchema(LocalDateTime, "LOCAL_DATE_TIME", properties)
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// timeSizes holds the size of the payloads of the time schemas
+var timeSizes = map[SchemaType]int{
+	DATE:          8,
+	TIME:          8,
+	TIMESTAMP:     8,
+	INSTANT:       12,
+	LocalDate:     8,
+	LocalTime:     8,
+	LocalDateTime: 16,
+}
+
+func (ts *TimeSchema) Encode(value interface{}) ([]byte, error) {
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		t = *v
+	default:
+		return nil, newError(InvalidMessage, fmt.Sprintf("%s schema cannot encode %T", ts.Name, value))
+	}
+
+	data := make([]byte, timeSizes[ts.Type])
+	switch ts.Type {
+	case DATE, TIME, TIMESTAMP:
+		millis := t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+		binary.BigEndian.PutUint64(data, uint64(millis))
+	case INSTANT:
+		binary.BigEndian.PutUint64(data, uint64(t.Unix()))
+		binary.BigEndian.PutUint32(data[8:], uint32(t.Nanosecond()))
+	case LocalDate:
+		binary.BigEndian.PutUint64(data, uint64(epochDay(t)))
+	case LocalTime:
+		binary.BigEndian.PutUint64(data, uint64(nanoOfDay(t)))
+	case LocalDateTime:
+		binary.BigEndian.PutUint64(data, uint64(epochDay(t)))
+		binary.BigEndian.PutUint64(data[8:], uint64(nanoOfDay(t)))
+	}
+	return data, nil
+}
+
+func (ts *TimeSchema) Decode(data []byte, v interface{}) error {
+	if err := ts.Validate(data); err != nil {
+		return err
+	}
+	t, ok := v.(*time.Time)
+	if !ok {
+		return newError(InvalidMessage, fmt.Sprintf("%s schema cannot decode into %T", ts.Name, v))
+	}
+
+	first := int64(binary.BigEndian.Uint64(data))
+	switch ts.Type {
+	case DATE, TIME, TIMESTAMP:
+		*t = time.Unix(first/1000, first%1000*int64(time.Millisecond))
+	case INSTANT:
+		*t = time.Unix(first, int64(binary.BigEndian.Uint32(data[8:])))
+	case LocalDate:
+		*t = time.Unix(first*secondsPerDay, 0).UTC()
+	case LocalTime:
+		*t = time.Time{}.Add(time.Duration(first))
+	case LocalDateTime:
+		nanos := int64(binary.BigEndian.Uint64(data[8:]))
+		*t = time.Unix(first*secondsPerDay, nanos).UTC()
+	}
+	return nil
+}
+
+func (ts *TimeSchema) Validate(message []byte) error {
+	if size := timeSizes[ts.Type]; len(message) != size {
+		return newError(InvalidMessage, fmt.Sprintf("size of data received by %s schema is not %d", ts.Name, size))
+	}
+	return nil
+}
+
+func (ts *TimeSchema) GetSchemaInfo() *SchemaInfo {
+	return &ts.SchemaInfo
+}
+
+// epochDay returns the number of days between the epoch and the date of t, in the location of t
+func epochDay(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+}
+
+// nanoOfDay returns the nanoseconds elapsed since midnight, in the location of t
+func nanoOfDay(t time.Time) int64 {
+	return int64(t.Hour())*int64(time.Hour) + int64(t.Minute())*int64(time.Minute) +
+		int64(t.Second())*int64(time.Second) + int64(t.Nanosecond())
+}
@@ -0,0 +1,325 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// avroType is a parsed Avro definition, the references to named types point to their definition
+type avroType struct {
+	// kind is a primitive type, record, enum, array, map, fixed or union
+	kind string
+
+	// named types
+	fullName string
+	aliases  []string
+
+	fields      []*avroField
+	symbols     []string
+	enumDefault bool
+	size        int
+
+	items    *avroType
+	values   *avroType
+	branches []*avroType
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	typ        *avroType
+	hasDefault bool
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true, "float": true, "double": true,
+	"bytes": true, "string": true,
+}
+
+// avroParser parses a definition, collecting the named types
+type avroParser struct {
+	named map[string]*avroType
+}
+
+func parseAvro(definition string) (*avroType, error) {
+	var d interface{}
+	if err := json.Unmarshal([]byte(definition), &d); err != nil {
+		return nil, err
+	}
+	p := &avroParser{named: make(map[string]*avroType)}
+	return p.parse(d, "")
+}
+
+func (p *avroParser) parse(d interface{}, namespace string) (*avroType, error) {
+	switch v := d.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroType{kind: v}, nil
+		}
+		if t, ok := p.named[qualify(v, namespace)]; ok {
+			return t, nil
+		}
+		if t, ok := p.named[v]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %s", v)
+	case []interface{}:
+		union := &avroType{kind: "union"}
+		for _, branch := range v {
+			t, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, t)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseComplex(v, namespace)
+	}
+	return nil, fmt.Errorf("invalid definition %v", d)
+}
+
+func (p *avroParser) parseComplex(d map[string]interface{}, namespace string) (*avroType, error) {
+	kind, _ := d["type"].(string)
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		name, _ := d["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("the %s has no name", kind)
+		}
+		if ns, ok := d["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		t := &avroType{kind: kind, fullName: qualify(name, namespace)}
+		if i := strings.LastIndex(t.fullName, "."); i >= 0 {
+			namespace = t.fullName[:i]
+		}
+		for _, alias := range stringList(d["aliases"]) {
+			t.aliases = append(t.aliases, qualify(alias, namespace))
+		}
+		p.named[t.fullName] = t
+
+		switch kind {
+		case "record", "error":
+			t.kind = "record"
+			fields, _ := d["fields"].([]interface{})
+			for _, f := range fields {
+				fm, ok := f.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("invalid field of %s", t.fullName)
+				}
+				field := &avroField{aliases: stringList(fm["aliases"])}
+				field.name, _ = fm["name"].(string)
+				_, field.hasDefault = fm["default"]
+				var err error
+				if field.typ, err = p.parse(fm["type"], namespace); err != nil {
+					return nil, fmt.Errorf("field %s of %s: %v", field.name, t.fullName, err)
+				}
+				t.fields = append(t.fields, field)
+			}
+		case "enum":
+			t.symbols = stringList(d["symbols"])
+			_, t.enumDefault = d["default"]
+		case "fixed":
+			size, _ := d["size"].(float64)
+			t.size = int(size)
+		}
+		return t, nil
+	case "array":
+		items, err := p.parse(d["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: "array", items: items}, nil
+	case "map":
+		values, err := p.parse(d["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: "map", values: values}, nil
+	}
+	// a primitive type, possibly with a logical type
+	return p.parse(d["type"], namespace)
+}
+
+func qualify(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	strs := make([]string, 0, len(list))
+	for _, s := range list {
+		if str, ok := s.(string); ok {
+			strs = append(strs, str)
+		}
+	}
+	return strs
+}
+
+// promotions holds the writer types each reader type can read besides its own
+var promotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+// canRead returns the reasons why the data written with the writer schema cannot be read with the reader
+// schema, following the Avro schema resolution rules.
+func canRead(reader, writer *avroType) []string {
+	c := &checker{checked: make(map[[2]*avroType]bool)}
+	c.check(reader, writer, "")
+	return c.reasons
+}
+
+type checker struct {
+	// checked holds the pairs of reader and writer types already checked, for the recursive types
+	checked map[[2]*avroType]bool
+	reasons []string
+}
+
+func (c *checker) fail(path, format string, args ...interface{}) {
+	if path == "" {
+		path = "root"
+	}
+	c.reasons = append(c.reasons, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (c *checker) check(reader, writer *avroType, path string) {
+	pair := [2]*avroType{reader, writer}
+	if c.checked[pair] {
+		return
+	}
+	c.checked[pair] = true
+
+	if writer.kind == "union" {
+		for _, branch := range writer.branches {
+			c.check(reader, branch, path)
+		}
+		return
+	}
+	if reader.kind == "union" {
+		for _, branch := range reader.branches {
+			if matches(branch, writer) {
+				c.check(branch, writer, path)
+				return
+			}
+		}
+		c.fail(path, "no branch of the reader union can read the writer type %s", describe(writer))
+		return
+	}
+
+	if !matches(reader, writer) {
+		c.fail(path, "the writer type %s cannot be read as %s", describe(writer), describe(reader))
+		return
+	}
+
+	switch reader.kind {
+	case "record":
+		c.checkRecord(reader, writer, path)
+	case "enum":
+		if reader.enumDefault {
+			return
+		}
+		for _, symbol := range writer.symbols {
+			if !contains(reader.symbols, symbol) {
+				c.fail(path, "the reader enum %s has no symbol %s and no default", reader.fullName, symbol)
+			}
+		}
+	case "fixed":
+		if reader.size != writer.size {
+			c.fail(path, "the size of the fixed %s changes from %d to %d", reader.fullName, writer.size, reader.size)
+		}
+	case "array":
+		c.check(reader.items, writer.items, path+"[]")
+	case "map":
+		c.check(reader.values, writer.values, path+"{}")
+	}
+}
+
+func (c *checker) checkRecord(reader, writer *avroType, path string) {
+	for _, rf := range reader.fields {
+		fieldPath := rf.name
+		if path != "" {
+			fieldPath = path + "." + rf.name
+		}
+
+		wf := writerField(writer, rf)
+		if wf == nil {
+			if !rf.hasDefault {
+				c.fail(fieldPath, "the reader field has no default and is missing from the writer")
+			}
+			continue
+		}
+		c.check(rf.typ, wf.typ, fieldPath)
+	}
+}
+
+// writerField returns the field of the writer record read as the reader field, matched by name or alias
+func writerField(writer *avroType, rf *avroField) *avroField {
+	for _, wf := range writer.fields {
+		if wf.name == rf.name {
+			return wf
+		}
+	}
+	for _, wf := range writer.fields {
+		if contains(rf.aliases, wf.name) {
+			return wf
+		}
+	}
+	return nil
+}
+
+// matches returns true when the kinds of the types allow the reader to read the writer data
+func matches(reader, writer *avroType) bool {
+	switch reader.kind {
+	case "record", "enum", "fixed":
+		return reader.kind == writer.kind && (shortName(reader.fullName) == shortName(writer.fullName) ||
+			contains(reader.aliases, writer.fullName))
+	}
+	return reader.kind == writer.kind || contains(promotions[reader.kind], writer.kind)
+}
+
+// shortName returns the unqualified name of a named type, which the resolution rules compare
+func shortName(fullName string) string {
+	return fullName[strings.LastIndex(fullName, ".")+1:]
+}
+
+func describe(t *avroType) string {
+	if t.fullName != "" {
+		return t.kind + " " + t.fullName
+	}
+	return t.kind
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
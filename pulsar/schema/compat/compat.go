@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package compat checks offline whether a schema can replace the schemas of a topic, following the
+// compatibility strategies of the broker, so that incompatible changes are caught before producers
+// fail to connect.
+//
+// The Avro and JSON schemas are checked with the Avro schema resolution rules, as the broker does for both.
+package compat
+
+import (
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+// Strategy is a schema compatibility strategy of a topic
+type Strategy int
+
+const (
+	// Backward requires the new schema to read the data written with the latest schema
+	Backward Strategy = iota
+	// BackwardTransitive requires the new schema to read the data written with all the previous schemas
+	BackwardTransitive
+	// Forward requires the latest schema to read the data written with the new schema
+	Forward
+	// ForwardTransitive requires all the previous schemas to read the data written with the new schema
+	ForwardTransitive
+	// Full is both Backward and Forward
+	Full
+	// FullTransitive is both BackwardTransitive and ForwardTransitive
+	FullTransitive
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case Backward:
+		return "BACKWARD"
+	case BackwardTransitive:
+		return "BACKWARD_TRANSITIVE"
+	case Forward:
+		return "FORWARD"
+	case ForwardTransitive:
+		return "FORWARD_TRANSITIVE"
+	case Full:
+		return "FULL"
+	case FullTransitive:
+		return "FULL_TRANSITIVE"
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+func (s Strategy) transitive() bool {
+	return s == BackwardTransitive || s == ForwardTransitive || s == FullTransitive
+}
+
+func (s Strategy) backward() bool {
+	return s != Forward && s != ForwardTransitive
+}
+
+func (s Strategy) forward() bool {
+	return s != Backward && s != BackwardTransitive
+}
+
+// Result is the outcome of a compatibility check
+type Result struct {
+	// Compatible is set when the new schema can replace the existing ones
+	Compatible bool
+	// Reasons explains why the schemas are not compatible
+	Reasons []string
+}
+
+func (r *Result) String() string {
+	if r.Compatible {
+		return "compatible"
+	}
+	return fmt.Sprintf("incompatible: %v", r.Reasons)
+}
+
+// Check returns whether the proposed schema can replace the existing schema with the strategy, the transitive
+// strategies are the same as the others with a single existing schema.
+func Check(existing, proposed *pulsar.SchemaInfo, strategy Strategy) (*Result, error) {
+	return CheckHistory([]*pulsar.SchemaInfo{existing}, proposed, strategy)
+}
+
+// CheckHistory returns whether the proposed schema can replace the schemas of a topic, from the oldest to the
+// latest, with the strategy. Only the latest schema is checked unless the strategy is transitive.
+func CheckHistory(history []*pulsar.SchemaInfo, proposed *pulsar.SchemaInfo, strategy Strategy) (*Result, error) {
+	if strategy < Backward || strategy > FullTransitive {
+		return nil, fmt.Errorf("unknown compatibility strategy %v", strategy)
+	}
+	if !strategy.transitive() && len(history) > 1 {
+		history = history[len(history)-1:]
+	}
+
+	proposedSchema, err := parseSchema(proposed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposed schema: %v", err)
+	}
+
+	result := &Result{Compatible: true}
+	for i, existing := range history {
+		prefix := ""
+		if len(history) > 1 {
+			prefix = fmt.Sprintf("schema %d: ", i)
+		}
+		if existing.Type != proposed.Type {
+			result.Reasons = append(result.Reasons,
+				fmt.Sprintf("%sthe schema type changes from %v to %v", prefix, existing.Type, proposed.Type))
+			continue
+		}
+		if existing.Schema == proposed.Schema {
+			continue
+		}
+
+		existingSchema, err := parseSchema(existing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid existing schema %d: %v", i, err)
+		}
+		if strategy.backward() {
+			for _, reason := range canRead(proposedSchema, existingSchema) {
+				result.Reasons = append(result.Reasons, prefix+"backward: "+reason)
+			}
+		}
+		if strategy.forward() {
+			for _, reason := range canRead(existingSchema, proposedSchema) {
+				result.Reasons = append(result.Reasons, prefix+"forward: "+reason)
+			}
+		}
+	}
+	result.Compatible = len(result.Reasons) == 0
+	return result, nil
+}
+
+func parseSchema(info *pulsar.SchemaInfo) (*avroType, error) {
+	if info == nil {
+		return nil, fmt.Errorf("missing schema")
+	}
+	switch info.Type {
+	case pulsar.AVRO, pulsar.JSON:
+		return parseAvro(info.Schema)
+	}
+	return nil, fmt.Errorf("the compatibility of the %v schemas cannot be checked", info.Type)
+}
@@ -0,0 +1,194 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package compat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+func avro(fields string) *pulsar.SchemaInfo {
+	return pulsar.NewAvroSchema(`{"type":"record","name":"User","namespace":"test","fields":[`+fields+`]}`,
+		nil).GetSchemaInfo()
+}
+
+func check(t *testing.T, existing, proposed *pulsar.SchemaInfo, strategy Strategy) *Result {
+	result, err := Check(existing, proposed, strategy)
+	assert.Nil(t, err)
+	return result
+}
+
+func TestCheckFields(t *testing.T) {
+	v1 := avro(`{"name":"id","type":"int"},{"name":"name","type":"string"}`)
+
+	// a new field with a default
+	v2 := avro(`{"name":"id","type":"int"},{"name":"name","type":"string"},` +
+		`{"name":"email","type":"string","default":""}`)
+	for _, strategy := range []Strategy{Backward, Forward, Full} {
+		assert.True(t, check(t, v1, v2, strategy).Compatible, strategy.String())
+	}
+
+	// a new field without default
+	v3 := avro(`{"name":"id","type":"int"},{"name":"name","type":"string"},{"name":"email","type":"string"}`)
+	result := check(t, v1, v3, Backward)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{"backward: email: the reader field has no default and is missing from the writer"},
+		result.Reasons)
+	assert.True(t, check(t, v1, v3, Forward).Compatible)
+	assert.False(t, check(t, v1, v3, Full).Compatible)
+
+	// a removed field without default
+	v4 := avro(`{"name":"id","type":"int"}`)
+	assert.True(t, check(t, v1, v4, Backward).Compatible)
+	assert.False(t, check(t, v1, v4, Forward).Compatible)
+
+	// a renamed field with an alias
+	v5 := avro(`{"name":"id","type":"int"},{"name":"fullName","type":"string","aliases":["name"]}`)
+	assert.True(t, check(t, v1, v5, Backward).Compatible)
+	assert.False(t, check(t, v1, v5, Forward).Compatible)
+}
+
+func TestCheckTypes(t *testing.T) {
+	v1 := avro(`{"name":"id","type":"int"}`)
+
+	// int is promoted to long
+	v2 := avro(`{"name":"id","type":"long"}`)
+	assert.True(t, check(t, v1, v2, Backward).Compatible)
+	result := check(t, v1, v2, Forward)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{"forward: id: the writer type long cannot be read as int"}, result.Reasons)
+
+	// the nullable fields
+	v3 := avro(`{"name":"id","type":["null","int"],"default":null}`)
+	assert.True(t, check(t, v1, v3, Backward).Compatible)
+	result = check(t, v1, v3, Forward)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{"forward: id: the writer type null cannot be read as int"}, result.Reasons)
+
+	// the enums
+	e1 := avro(`{"name":"kind","type":{"type":"enum","name":"Kind","symbols":["A","B"]}}`)
+	e2 := avro(`{"name":"kind","type":{"type":"enum","name":"Kind","symbols":["A"]}}`)
+	e3 := avro(`{"name":"kind","type":{"type":"enum","name":"Kind","symbols":["A"],"default":"A"}}`)
+	assert.False(t, check(t, e1, e2, Backward).Compatible)
+	assert.True(t, check(t, e1, e2, Forward).Compatible)
+	assert.True(t, check(t, e1, e3, Full).Compatible)
+
+	// the nested records, arrays and maps
+	n1 := avro(`{"name":"address","type":{"type":"record","name":"Address","fields":[` +
+		`{"name":"zip","type":"int"}]}},{"name":"tags","type":{"type":"map","values":{"type":"array","items":"int"}}}`)
+	n2 := avro(`{"name":"address","type":{"type":"record","name":"Address","fields":[` +
+		`{"name":"zip","type":"string"}]}},{"name":"tags","type":{"type":"map","values":{"type":"array","items":"long"}}}`)
+	result = check(t, n1, n2, Backward)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{"backward: address.zip: the writer type int cannot be read as string"}, result.Reasons)
+
+	// the records are matched by name
+	r2 := pulsar.NewAvroSchema(`{"type":"record","name":"Account","namespace":"test","fields":[`+
+		`{"name":"id","type":"int"}]}`, nil).GetSchemaInfo()
+	assert.False(t, check(t, v1, r2, Backward).Compatible)
+
+	// the recursive types
+	node := avro(`{"name":"next","type":["null","User"],"default":null}`)
+	assert.True(t, check(t, node, node, Full).Compatible)
+	node2 := avro(`{"name":"next","type":["null","User"],"default":null},{"name":"v","type":"int","default":0}`)
+	assert.True(t, check(t, node, node2, Full).Compatible)
+}
+
+func TestCheckHistory(t *testing.T) {
+	v1 := avro(`{"name":"id","type":"int"}`)
+	v2 := avro(`{"name":"id","type":"int"},{"name":"email","type":"string","default":""}`)
+	v3 := avro(`{"name":"id","type":"int"},{"name":"email","type":"string"}`)
+	history := []*pulsar.SchemaInfo{v1, v2}
+
+	result, err := CheckHistory(history, v3, Backward)
+	assert.Nil(t, err)
+	assert.True(t, result.Compatible)
+
+	result, err = CheckHistory(history, v3, BackwardTransitive)
+	assert.Nil(t, err)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{
+		"schema 0: backward: email: the reader field has no default and is missing from the writer",
+	}, result.Reasons)
+
+	result, err = CheckHistory(history, v3, FullTransitive)
+	assert.Nil(t, err)
+	assert.False(t, result.Compatible)
+	result, err = CheckHistory(history, v3, ForwardTransitive)
+	assert.Nil(t, err)
+	assert.True(t, result.Compatible)
+}
+
+// the records are named after the struct types, the versions of a struct are declared in their own scope
+func userV1(t *testing.T) *pulsar.SchemaInfo {
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	js, err := pulsar.NewJSONSchemaFromStruct(user{}, nil)
+	assert.Nil(t, err)
+	return js.GetSchemaInfo()
+}
+
+func userV2(t *testing.T) *pulsar.SchemaInfo {
+	type user struct {
+		ID    int     `json:"id"`
+		Name  string  `json:"name"`
+		Email *string `json:"email"`
+	}
+	js, err := pulsar.NewJSONSchemaFromStruct(user{}, nil)
+	assert.Nil(t, err)
+	return js.GetSchemaInfo()
+}
+
+func userV3(t *testing.T) *pulsar.SchemaInfo {
+	type user struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	js, err := pulsar.NewJSONSchemaFromStruct(user{}, nil)
+	assert.Nil(t, err)
+	return js.GetSchemaInfo()
+}
+
+func TestCheckSchemas(t *testing.T) {
+	v1 := userV1(t)
+
+	// the pointers are nullable with a null default
+	assert.True(t, check(t, v1, userV2(t), Full).Compatible)
+	assert.False(t, check(t, v1, userV3(t), Full).Compatible)
+
+	// the types must match
+	result := check(t, v1, avro(`{"name":"id","type":"long"}`), Full)
+	assert.False(t, result.Compatible)
+	assert.Equal(t, []string{"the schema type changes from JSON to AVRO"}, result.Reasons)
+
+	_, err := Check(pulsar.NewStringSchema(nil).GetSchemaInfo(), pulsar.NewStringSchema(nil).GetSchemaInfo(), Full)
+	assert.NotNil(t, err)
+	_, err = Check(avro(`{"name":"id","type":"int"}`), &pulsar.SchemaInfo{Type: pulsar.AVRO, Schema: "{"}, Full)
+	assert.NotNil(t, err)
+	_, err = Check(avro(`{"name":"id","type":"int"}`), avro(`{"name":"id","type":"int"}`), Strategy(10))
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "FULL_TRANSITIVE", FullTransitive.String())
+	assert.Equal(t, "incompatible: [the schema type changes from JSON to AVRO]", result.String())
+}
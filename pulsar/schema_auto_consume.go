@@ -0,0 +1,159 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+	pb "github.com/apache/pulsar-client-go/pulsar/internal/pulsar_proto"
+)
+
+// AutoConsumeSchema decodes the messages into a *GenericRecord with the schema they were produced with,
+// without the Go type of the messages. It is to be used with a consumer, which fetches the schema of the
+// topic from the broker when subscribing and then every schema version found in the messages.
+// Avro, JSON and Protobuf schemas are supported.
+type AutoConsumeSchema struct {
+	SchemaInfo
+
+	// fetch returns the schema of the topic with the given version, set once bound to a topic
+	fetch func(schemaVersion []byte) (*SchemaInfo, error)
+
+	lock     sync.Mutex
+	latest   *genericSchema
+	versions map[string]*genericSchema
+}
+
+func NewAutoConsumeSchema() *AutoConsumeSchema {
+	acs := new(AutoConsumeSchema)
+	acs.SchemaInfo.Name = "AutoConsume"
+	acs.SchemaInfo.Type = AutoConsume
+	return acs
+}
+
+// bind returns a copy of the schema decoding the messages of a topic with the schemas returned by fetch
+func (acs *AutoConsumeSchema) bind(fetch func(schemaVersion []byte) (*SchemaInfo, error)) *AutoConsumeSchema {
+	bound := NewAutoConsumeSchema()
+	bound.fetch = fetch
+	bound.versions = make(map[string]*genericSchema)
+	return bound
+}
+
+// fetchLatest fetches the latest schema of the topic, used for the messages without schema version
+func (acs *AutoConsumeSchema) fetchLatest() error {
+	gs, err := acs.fetchSchema(nil)
+	if err != nil {
+		return err
+	}
+	acs.lock.Lock()
+	acs.latest = gs
+	acs.lock.Unlock()
+	return nil
+}
+
+func (acs *AutoConsumeSchema) fetchSchema(schemaVersion []byte) (*genericSchema, error) {
+	if acs.fetch == nil {
+		return nil, newError(InvalidConfiguration, "the AUTO_CONSUME schema can only be used with a consumer")
+	}
+	info, err := acs.fetch(schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	return newGenericSchema(info)
+}
+
+// schema returns the schema with the given version, fetching it when not known yet
+func (acs *AutoConsumeSchema) schema(schemaVersion []byte) (*genericSchema, error) {
+	acs.lock.Lock()
+	defer acs.lock.Unlock()
+
+	if len(schemaVersion) == 0 {
+		if acs.latest == nil {
+			gs, err := acs.fetchSchema(nil)
+			if err != nil {
+				return nil, err
+			}
+			acs.latest = gs
+		}
+		return acs.latest, nil
+	}
+
+	if gs, ok := acs.versions[string(schemaVersion)]; ok {
+		return gs, nil
+	}
+	gs, err := acs.fetchSchema(schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	acs.versions[string(schemaVersion)] = gs
+	return gs, nil
+}
+
+func (acs *AutoConsumeSchema) Encode(v interface{}) ([]byte, error) {
+	return nil, newError(InvalidMessage, "the AUTO_CONSUME schema cannot encode messages")
+}
+
+// Decode decodes the data into a *GenericRecord with the latest schema of the topic, the messages are
+// decoded with the schema version they were produced with by Message.GetSchemaValue.
+func (acs *AutoConsumeSchema) Decode(data []byte, v interface{}) error {
+	return acs.decode(nil, data, v)
+}
+
+// decodeMessage decodes the message with the schema it was produced with
+func (acs *AutoConsumeSchema) decodeMessage(msg *message, v interface{}) error {
+	return acs.decode(msg.schemaVersion, msg.payLoad, v)
+}
+
+func (acs *AutoConsumeSchema) decode(schemaVersion []byte, data []byte, v interface{}) error {
+	record, ok := v.(*GenericRecord)
+	if !ok {
+		return newError(InvalidMessage, "the AUTO_CONSUME schema decodes into a *GenericRecord")
+	}
+
+	gs, err := acs.schema(schemaVersion)
+	if err != nil {
+		return err
+	}
+	decoded, err := gs.decode(data)
+	if err != nil {
+		return err
+	}
+	*record = *decoded
+	return nil
+}
+
+func (acs *AutoConsumeSchema) Validate(message []byte) error {
+	return acs.Decode(message, &GenericRecord{})
+}
+
+func (acs *AutoConsumeSchema) GetSchemaInfo() *SchemaInfo {
+	return &acs.SchemaInfo
+}
+
+// fromProtoSchema converts the schema returned by the broker
+func fromProtoSchema(schema *pb.Schema) *SchemaInfo {
+	if schema == nil {
+		return &SchemaInfo{Type: NONE}
+	}
+	return &SchemaInfo{
+		Name:       schema.GetName(),
+		Schema:     string(schema.GetSchemaData()),
+		Type:       SchemaType(schema.GetType()),
+		Properties: internal.ConvertToStringMap(schema.GetProperties()),
+	}
+}
@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoConsumeSchemaVersions(t *testing.T) {
+	var fetched [][]byte
+	acs := NewAutoConsumeSchema().bind(func(schemaVersion []byte) (*SchemaInfo, error) {
+		fetched = append(fetched, schemaVersion)
+		if string(schemaVersion) == "missing" {
+			return nil, errors.New("schema not found")
+		}
+		return &SchemaInfo{Type: JSON, Schema: exampleSchemaDef}, nil
+	})
+
+	var record GenericRecord
+	msg := &message{payLoad: []byte(`{"ID":1,"Name":"pulsar"}`), schema: acs, schemaVersion: []byte("v1")}
+	assert.Nil(t, msg.GetSchemaValue(&record))
+	assert.Equal(t, int64(1), record.Field("ID"))
+	assert.Equal(t, "pulsar", record.Field("Name"))
+
+	// the schema versions are fetched once
+	assert.Nil(t, msg.GetSchemaValue(&record))
+	assert.Nil(t, acs.Decode(msg.payLoad, &record))
+	assert.Equal(t, [][]byte{[]byte("v1"), nil}, fetched)
+
+	msg.schemaVersion = []byte("missing")
+	assert.NotNil(t, msg.GetSchemaValue(&record))
+
+	var notARecord map[string]interface{}
+	assert.NotNil(t, acs.Decode(msg.payLoad, &notARecord))
+
+	_, err := acs.Encode(record)
+	assert.NotNil(t, err)
+
+	// not bound to the topic of a consumer
+	assert.NotNil(t, NewAutoConsumeSchema().Decode(msg.payLoad, &record))
+}
+
+func TestAutoConsumeSchema(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewAvroSchema(exampleSchemaDef, nil),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewAutoConsumeSchema(),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: testAvro{ID: 100, Name: "pulsar"},
+	})
+	assert.Nil(t, err)
+
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+
+	var record GenericRecord
+	assert.Nil(t, msg.GetSchemaValue(&record))
+	assert.Equal(t, AVRO, record.SchemaType())
+	assert.Equal(t, []string{"ID", "Name"}, record.Fields())
+	assert.Equal(t, int32(100), record.Field("ID"))
+	assert.Equal(t, "pulsar", record.Field("Name"))
+}
@@ -0,0 +1,132 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// AutoPublishSchema publishes payloads already encoded with the schema of the topic. The producer fetches the
+// schema of the topic when connecting, registers with it and checks every payload against it before sending,
+// so that invalid payloads fail on the client. The payload is either the Payload or the []byte Value of the
+// messages.
+type AutoPublishSchema struct {
+	SchemaInfo
+}
+
+func NewAutoPublishSchema() *AutoPublishSchema {
+	aps := new(AutoPublishSchema)
+	aps.SchemaInfo.Name = "AutoPublish"
+	aps.SchemaInfo.Type = AutoPublish
+	return aps
+}
+
+func (aps *AutoPublishSchema) Encode(v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, ok := v.([]byte)
+	if !ok {
+		return nil, newError(InvalidMessage, fmt.Sprintf("the AUTO_PUBLISH schema publishes []byte, not %T", v))
+	}
+	return data, nil
+}
+
+func (aps *AutoPublishSchema) Decode(data []byte, v interface{}) error {
+	return newError(InvalidMessage, "the AUTO_PUBLISH schema cannot decode messages")
+}
+
+func (aps *AutoPublishSchema) Validate(message []byte) error {
+	return nil
+}
+
+func (aps *AutoPublishSchema) GetSchemaInfo() *SchemaInfo {
+	return &aps.SchemaInfo
+}
+
+// payloadValidator checks that payloads are encoded with a schema
+type payloadValidator struct {
+	info    *SchemaInfo
+	generic *genericSchema
+	json    *jsonValidator
+}
+
+func newPayloadValidator(info *SchemaInfo) (*payloadValidator, error) {
+	v := &payloadValidator{info: info}
+	switch info.Type {
+	case AVRO, JSON, PROTOBUF, ProtoNative:
+		gs, err := newGenericSchema(info)
+		if err != nil {
+			return nil, err
+		}
+		v.generic = gs
+	}
+	if info.Type == JSON {
+		jv, err := newJSONValidator(info.Schema)
+		if err != nil {
+			return nil, err
+		}
+		v.json = jv
+	}
+	return v, nil
+}
+
+// fixedSizes holds the size of the payloads of the schemas of fixed size
+var fixedSizes = map[SchemaType]int{
+	BOOLEAN: 1,
+	INT8:    1,
+	INT16:   2,
+	INT32:   4,
+	INT64:   8,
+	FLOAT:   4,
+	DOUBLE:  8,
+}
+
+func (v *payloadValidator) validate(payload []byte) error {
+	var err error
+	switch v.info.Type {
+	case AVRO:
+		var rest []byte
+		if _, rest, err = v.generic.codec.NativeFromBinary(payload); err == nil && len(rest) > 0 {
+			err = fmt.Errorf("%d trailing bytes", len(rest))
+		}
+	case JSON:
+		err = v.json.validate(payload)
+	case PROTOBUF, ProtoNative:
+		_, err = v.generic.decode(payload)
+	case STRING:
+		if !utf8.Valid(payload) {
+			err = fmt.Errorf("invalid UTF-8 string")
+		}
+	default:
+		size, ok := fixedSizes[v.info.Type]
+		if !ok {
+			size, ok = timeSizes[v.info.Type]
+		}
+		if ok && len(payload) != size {
+			err = fmt.Errorf("size is %d instead of %d", len(payload), size)
+		}
+	}
+
+	if err != nil {
+		return newError(InvalidMessage, fmt.Sprintf("the payload does not match the %s schema of the topic: %v",
+			schemaTypeNames[v.info.Type], err))
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayloadValidator(t *testing.T) {
+	avro := NewAvroSchema(exampleSchemaDef, nil)
+	valid, err := avro.Encode(testAvro{ID: 1, Name: "pulsar"})
+	assert.Nil(t, err)
+
+	v, err := newPayloadValidator(&SchemaInfo{Type: AVRO, Schema: exampleSchemaDef})
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate(valid))
+	assert.NotNil(t, v.validate(valid[:len(valid)-1]))
+	assert.NotNil(t, v.validate(append(valid, 0)))
+
+	v, err = newPayloadValidator(&SchemaInfo{Type: JSON, Schema: exampleSchemaDef})
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate([]byte(`{"ID":1,"Name":"pulsar"}`)))
+	assert.NotNil(t, v.validate([]byte(`{"ID":1,`)))
+	assert.NotNil(t, v.validate([]byte(`[1, 2]`)))
+
+	v, err = newPayloadValidator(&SchemaInfo{Type: INT32})
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate([]byte{1, 2, 3, 4}))
+	assert.NotNil(t, v.validate([]byte{1, 2}))
+
+	v, err = newPayloadValidator(&SchemaInfo{Type: STRING})
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate([]byte("hello")))
+	assert.NotNil(t, v.validate([]byte{0xff, 0xfe}))
+
+	v, err = newPayloadValidator(&SchemaInfo{Type: BYTES})
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate([]byte{0xff, 0xfe}))
+}
+
+func TestAutoPublishSchemaEncode(t *testing.T) {
+	aps := NewAutoPublishSchema()
+
+	data, err := aps.Encode([]byte("payload"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("payload"), data)
+
+	data, err = aps.Encode(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, data)
+
+	_, err = aps.Encode("payload")
+	assert.NotNil(t, err)
+}
+
+func TestAutoPublishSchema(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	topic := newTopicName()
+	avro := NewAvroSchema(exampleSchemaDef, nil)
+
+	// registers the schema of the topic
+	avroProducer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: avro,
+	})
+	assert.Nil(t, err)
+	avroProducer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewAvroSchema(exampleSchemaDef, nil),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewAutoPublishSchema(),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Payload: []byte("not avro"),
+	})
+	assert.NotNil(t, err)
+
+	payload, err := avro.Encode(testAvro{ID: 100, Name: "pulsar"})
+	assert.Nil(t, err)
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Payload: payload,
+	})
+	assert.Nil(t, err)
+
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+	var value testAvro
+	assert.Nil(t, msg.GetSchemaValue(&value))
+	assert.Equal(t, testAvro{ID: 100, Name: "pulsar"}, value)
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"reflect"
+	"strings"
+)
+
+// avroNames holds the named types of a parsed Avro definition
+type avroNames struct {
+	// named holds the definitions of the named types by full name and by name
+	named map[string]map[string]interface{}
+	// fullNames holds the full names of the definitions of the named types
+	fullNames map[uintptr]string
+}
+
+func newAvroNames() *avroNames {
+	return &avroNames{
+		named:     make(map[string]map[string]interface{}),
+		fullNames: make(map[uintptr]string),
+	}
+}
+
+// register collects the named types of the definition
+func (n *avroNames) register(definition interface{}, namespace string) {
+	switch d := definition.(type) {
+	case []interface{}:
+		for _, branch := range d {
+			n.register(branch, namespace)
+		}
+	case map[string]interface{}:
+		switch d["type"] {
+		case "record", "error", "enum", "fixed":
+			name, _ := d["name"].(string)
+			if ns, ok := d["namespace"].(string); ok {
+				namespace = ns
+			}
+			fullName := name
+			if namespace != "" && !strings.Contains(name, ".") {
+				fullName = namespace + "." + name
+			}
+			n.named[fullName] = d
+			n.named[name[strings.LastIndex(name, ".")+1:]] = d
+			n.fullNames[reflect.ValueOf(d).Pointer()] = fullName
+			if fields, ok := d["fields"].([]interface{}); ok {
+				for _, f := range fields {
+					if fm, ok := f.(map[string]interface{}); ok {
+						n.register(fm["type"], namespace)
+					}
+				}
+			}
+		case "array":
+			n.register(d["items"], namespace)
+		case "map":
+			n.register(d["values"], namespace)
+		default:
+			n.register(d["type"], namespace)
+		}
+	}
+}
+
+// resolve returns the definition of a named type, or the definition itself
+func (n *avroNames) resolve(definition interface{}) interface{} {
+	if name, ok := definition.(string); ok {
+		if d, ok := n.named[name]; ok {
+			return d
+		}
+	}
+	return definition
+}
+
+// fullName returns the full name of the definition of a named type
+func (n *avroNames) fullName(definition map[string]interface{}) string {
+	if fullName, ok := n.fullNames[reflect.ValueOf(definition).Pointer()]; ok {
+		return fullName
+	}
+	name, _ := definition["name"].(string)
+	return name
+}
+
+// typeName returns the type of the definition: a primitive type or record, enum, array, map, fixed
+// or union for the unions
+func (n *avroNames) typeName(definition interface{}) string {
+	switch d := n.resolve(definition).(type) {
+	case string:
+		return d
+	case []interface{}:
+		return "union"
+	case map[string]interface{}:
+		switch t := d["type"]; t {
+		case "record", "error":
+			return "record"
+		case "enum", "array", "map", "fixed":
+			return t.(string)
+		default:
+			return n.typeName(t)
+		}
+	}
+	return ""
+}
+
+// branchName returns the name goavro gives to a branch of a union: the full name of the named types,
+// the type and the logical type of the logical types and the type of the others
+func (n *avroNames) branchName(branch interface{}) string {
+	d := n.resolve(branch)
+	if m, ok := d.(map[string]interface{}); ok {
+		switch n.typeName(m) {
+		case "record", "enum", "fixed":
+			return n.fullName(m)
+		}
+		if logicalType, ok := m["logicalType"].(string); ok {
+			// goavro names the branches of the logical types after both types
+			return n.typeName(m) + "." + logicalType
+		}
+	}
+	return n.typeName(d)
+}
@@ -0,0 +1,332 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+// KeyValueEncodingType defines how the key and the value of a KeyValue schema are laid out in the messages
+type KeyValueEncodingType int
+
+const (
+	// KeyValueEncodingInline stores the encoded key and value together in the message payload
+	KeyValueEncodingInline KeyValueEncodingType = iota
+	// KeyValueEncodingSeparated stores the encoded value in the message payload and the encoded key, base64
+	// encoded, in the message key, so that the messages can be routed and compacted by key
+	KeyValueEncodingSeparated
+)
+
+func (e KeyValueEncodingType) String() string {
+	if e == KeyValueEncodingSeparated {
+		return "SEPARATED"
+	}
+	return "INLINE"
+}
+
+// the properties describing the key and the value schemas in the combined schema info, as set by the Java client
+const (
+	kvKeySchemaName         = "key.schema.name"
+	kvKeySchemaType         = "key.schema.type"
+	kvKeySchemaProperties   = "key.schema.properties"
+	kvValueSchemaName       = "value.schema.name"
+	kvValueSchemaType       = "value.schema.type"
+	kvValueSchemaProperties = "value.schema.properties"
+	kvEncodingType          = "kv.encoding.type"
+)
+
+var schemaTypeNames = map[SchemaType]string{
+	NONE:          "NONE",
+	STRING:        "STRING",
+	JSON:          "JSON",
+	PROTOBUF:      "PROTOBUF",
+	AVRO:          "AVRO",
+	BOOLEAN:       "BOOLEAN",
+	INT8:          "INT8",
+	INT16:         "INT16",
+	INT32:         "INT32",
+	INT64:         "INT64",
+	FLOAT:         "FLOAT",
+	DOUBLE:        "DOUBLE",
+	DATE:          "DATE",
+	TIME:          "TIME",
+	TIMESTAMP:     "TIMESTAMP",
+	KeyValue:      "KEY_VALUE",
+	INSTANT:       "INSTANT",
+	LocalDate:     "LOCAL_DATE",
+	LocalTime:     "LOCAL_TIME",
+	LocalDateTime: "LOCAL_DATE_TIME",
+	ProtoNative:   "PROTOBUF_NATIVE",
+	BYTES:         "BYTES",
+	AUTO:          "AUTO",
+	AutoConsume:   "AUTO_CONSUME",
+	AutoPublish:   "AUTO_PUBLISH",
+}
+
+// KeyValuePair is the value of the messages of a KeyValue schema. It is not named KeyValue, which is already
+// the SchemaType of these schemas. When decoding, Key and Value must hold pointers the key and the value are
+// decoded into, if nil they are set to the encoded bytes. A nil Key or Value is encoded as a missing key or value.
+type KeyValuePair struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// KeyValueSchema encodes a KeyValuePair with the key schema and the value schema
+type KeyValueSchema struct {
+	SchemaInfo
+	keySchema   Schema
+	valueSchema Schema
+	encoding    KeyValueEncodingType
+}
+
+// NewKeyValueSchema creates the KeyValue schema combining the key and the value schemas. The schema info is
+// compatible with the one of the Java client, to produce and consume the same topics.
+func NewKeyValueSchema(keySchema, valueSchema Schema, encoding KeyValueEncodingType) *KeyValueSchema {
+	keyInfo := keySchema.GetSchemaInfo()
+	valueInfo := valueSchema.GetSchemaInfo()
+
+	kvs := new(KeyValueSchema)
+	kvs.keySchema = keySchema
+	kvs.valueSchema = valueSchema
+	kvs.encoding = encoding
+	kvs.SchemaInfo.Name = "KeyValue"
+	kvs.SchemaInfo.Type = KeyValue
+	kvs.SchemaInfo.Schema = string(encodeKeyValue([]byte(keyInfo.Schema), []byte(valueInfo.Schema)))
+	kvs.SchemaInfo.Properties = map[string]string{
+		kvKeySchemaName:         keyInfo.Name,
+		kvKeySchemaType:         schemaTypeNames[keyInfo.Type],
+		kvKeySchemaProperties:   schemaPropertiesJSON(keyInfo.Properties),
+		kvValueSchemaName:       valueInfo.Name,
+		kvValueSchemaType:       schemaTypeNames[valueInfo.Type],
+		kvValueSchemaProperties: schemaPropertiesJSON(valueInfo.Properties),
+		kvEncodingType:          encoding.String(),
+	}
+	return kvs
+}
+
+// KeySchema returns the schema of the keys
+func (kvs *KeyValueSchema) KeySchema() Schema {
+	return kvs.keySchema
+}
+
+// ValueSchema returns the schema of the values
+func (kvs *KeyValueSchema) ValueSchema() Schema {
+	return kvs.valueSchema
+}
+
+// Encoding returns how the key and the value are laid out in the messages
+func (kvs *KeyValueSchema) Encoding() KeyValueEncodingType {
+	return kvs.encoding
+}
+
+// Encode encodes a KeyValuePair, with the SEPARATED encoding only the value is encoded in the payload and the
+// producer sets the message key.
+func (kvs *KeyValueSchema) Encode(v interface{}) ([]byte, error) {
+	kv, err := toKeyValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := encodeOrNil(kvs.valueSchema, kv.Value)
+	if err != nil {
+		return nil, err
+	}
+	if kvs.encoding == KeyValueEncodingSeparated {
+		return value, nil
+	}
+
+	key, err := encodeOrNil(kvs.keySchema, kv.Key)
+	if err != nil {
+		return nil, err
+	}
+	return encodeKeyValue(key, value), nil
+}
+
+// Decode decodes the payload of the INLINE encoding into a *KeyValuePair, the messages of the SEPARATED encoding
+// are decoded with Message.GetSchemaValue which also reads the message key.
+func (kvs *KeyValueSchema) Decode(data []byte, v interface{}) error {
+	if kvs.encoding == KeyValueEncodingSeparated {
+		return kvs.decodeSeparated("", data, v)
+	}
+
+	key, value, err := decodeKeyValue(data)
+	if err != nil {
+		return err
+	}
+	return kvs.decode(key, value, v)
+}
+
+func (kvs *KeyValueSchema) Validate(message []byte) error {
+	if kvs.encoding == KeyValueEncodingSeparated {
+		return kvs.valueSchema.Validate(message)
+	}
+
+	key, value, err := decodeKeyValue(message)
+	if err != nil {
+		return err
+	}
+	if key != nil {
+		if err := kvs.keySchema.Validate(key); err != nil {
+			return err
+		}
+	}
+	if value != nil {
+		return kvs.valueSchema.Validate(value)
+	}
+	return nil
+}
+
+func (kvs *KeyValueSchema) GetSchemaInfo() *SchemaInfo {
+	return &kvs.SchemaInfo
+}
+
+// encodeKey returns the message key of the SEPARATED encoding: the base64 encoded key
+func (kvs *KeyValueSchema) encodeKey(v interface{}) (string, error) {
+	kv, err := toKeyValue(v)
+	if err != nil {
+		return "", err
+	}
+	key, err := encodeOrNil(kvs.keySchema, kv.Key)
+	if err != nil || key == nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// decodeMessage decodes the key of the message along with its payload with the SEPARATED encoding
+func (kvs *KeyValueSchema) decodeMessage(msg *message, v interface{}) error {
+	if kvs.encoding == KeyValueEncodingSeparated {
+		return kvs.decodeSeparated(msg.key, msg.payLoad, v)
+	}
+	return kvs.Decode(msg.payLoad, v)
+}
+
+// decodeSeparated decodes the message key, base64 encoded, and the payload of the SEPARATED encoding
+func (kvs *KeyValueSchema) decodeSeparated(messageKey string, payload []byte, v interface{}) error {
+	var key []byte
+	if messageKey != "" {
+		var err error
+		if key, err = base64.StdEncoding.DecodeString(messageKey); err != nil {
+			return newError(InvalidMessage, fmt.Sprintf("invalid KeyValue message key: %v", err))
+		}
+	}
+	return kvs.decode(key, payload, v)
+}
+
+func (kvs *KeyValueSchema) decode(key, value []byte, v interface{}) error {
+	kv, ok := v.(*KeyValuePair)
+	if !ok {
+		return fmt.Errorf("a KeyValue schema decodes into a *KeyValuePair, not %T", v)
+	}
+
+	var err error
+	if kv.Key, err = decodeOrNil(kvs.keySchema, key, kv.Key); err != nil {
+		return err
+	}
+	kv.Value, err = decodeOrNil(kvs.valueSchema, value, kv.Value)
+	return err
+}
+
+func toKeyValue(v interface{}) (KeyValuePair, error) {
+	switch kv := v.(type) {
+	case KeyValuePair:
+		return kv, nil
+	case *KeyValuePair:
+		if kv != nil {
+			return *kv, nil
+		}
+	}
+	return KeyValuePair{}, fmt.Errorf("a KeyValue schema encodes a KeyValuePair, not %T", v)
+}
+
+func encodeOrNil(schema Schema, v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return schema.Encode(v)
+}
+
+// decodeOrNil decodes data into target, a nil target gets the data itself and missing data gives nil
+func decodeOrNil(schema Schema, data []byte, target interface{}) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if target == nil {
+		return data, nil
+	}
+	return target, schema.Decode(data, target)
+}
+
+var errInvalidKeyValue = newError(InvalidMessage, "invalid KeyValue encoding")
+
+// encodeKeyValue lays out the key and the value as the Java client does: the length of the key as a big endian
+// int32, the key, the length of the value and the value, with a -1 length for a missing key or value.
+func encodeKeyValue(key, value []byte) []byte {
+	buf := make([]byte, 0, 8+len(key)+len(value))
+	buf = appendLengthPrefixed(buf, key)
+	return appendLengthPrefixed(buf, value)
+}
+
+func appendLengthPrefixed(buf, data []byte) []byte {
+	length := int32(len(data))
+	if data == nil {
+		length = -1
+	}
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(length))
+	return append(append(buf, size[:]...), data...)
+}
+
+func decodeKeyValue(data []byte) (key, value []byte, err error) {
+	if key, data, err = readLengthPrefixed(data); err != nil {
+		return nil, nil, err
+	}
+	if value, _, err = readLengthPrefixed(data); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+func readLengthPrefixed(data []byte) (field, rest []byte, err error) {
+	if len(data) < 4 {
+		return nil, nil, errInvalidKeyValue
+	}
+	length := int32(binary.BigEndian.Uint32(data))
+	data = data[4:]
+	if length < 0 {
+		return nil, data, nil
+	}
+	if int(length) > len(data) {
+		return nil, nil, errInvalidKeyValue
+	}
+	return data[:length:length], data[length:], nil
+}
+
+func schemaPropertiesJSON(properties map[string]string) string {
+	if properties == nil {
+		properties = map[string]string{}
+	}
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
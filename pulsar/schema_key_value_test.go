@@ -0,0 +1,142 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyValueSchemaInfo(t *testing.T) {
+	kvs := NewKeyValueSchema(NewStringSchema(nil), NewAvroSchema(exampleSchemaDef, map[string]string{"a": "b"}),
+		KeyValueEncodingSeparated)
+
+	info := kvs.GetSchemaInfo()
+	assert.Equal(t, "KeyValue", info.Name)
+	assert.Equal(t, KeyValue, info.Type)
+	assert.Equal(t, map[string]string{
+		"key.schema.name":         "String",
+		"key.schema.type":         "STRING",
+		"key.schema.properties":   "{}",
+		"value.schema.name":       "Avro",
+		"value.schema.type":       "AVRO",
+		"value.schema.properties": `{"a":"b"}`,
+		"kv.encoding.type":        "SEPARATED",
+	}, info.Properties)
+
+	key, value, err := decodeKeyValue([]byte(info.Schema))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{}, key)
+	assert.Equal(t, NewAvroSchema(exampleSchemaDef, nil).Schema, string(value))
+}
+
+func TestKeyValueSchemaInline(t *testing.T) {
+	kvs := NewKeyValueSchema(NewInt32Schema(nil), NewAvroSchema(exampleSchemaDef, nil), KeyValueEncodingInline)
+
+	data, err := kvs.Encode(KeyValuePair{Key: int32(7), Value: testAvro{ID: 100, Name: "pulsar"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 4, 7, 0, 0, 0}, data[:8])
+
+	var key int32
+	var value testAvro
+	kv := KeyValuePair{Key: &key, Value: &value}
+	assert.Nil(t, kvs.Decode(data, &kv))
+	assert.Equal(t, int32(7), key)
+	assert.Equal(t, testAvro{ID: 100, Name: "pulsar"}, value)
+
+	// a missing key is kept missing
+	data, err = kvs.Encode(&KeyValuePair{Value: testAvro{ID: 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, data[:4])
+	kv = KeyValuePair{Key: &key, Value: &value}
+	assert.Nil(t, kvs.Decode(data, &kv))
+	assert.Nil(t, kv.Key)
+
+	// without target the encoded bytes are returned
+	kv = KeyValuePair{}
+	assert.Nil(t, kvs.Decode([]byte{0, 0, 0, 1, 'k', 0, 0, 0, 1, 'v'}, &kv))
+	assert.Equal(t, []byte("k"), kv.Key)
+	assert.Equal(t, []byte("v"), kv.Value)
+
+	assert.NotNil(t, kvs.Decode([]byte{0, 0, 0, 8, 'k'}, &kv))
+	_, err = kvs.Encode("not a key value")
+	assert.NotNil(t, err)
+}
+
+func TestKeyValueSchemaSeparated(t *testing.T) {
+	kvs := NewKeyValueSchema(NewInt32Schema(nil), NewAvroSchema(exampleSchemaDef, nil), KeyValueEncodingSeparated)
+
+	kv := KeyValuePair{Key: int32(7), Value: testAvro{ID: 100, Name: "pulsar"}}
+	payload, err := kvs.Encode(kv)
+	assert.Nil(t, err)
+	key, err := kvs.encodeKey(kv)
+	assert.Nil(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte{7, 0, 0, 0}), key)
+
+	var decodedKey int32
+	var decodedValue testAvro
+	msg := &message{key: key, payLoad: payload, schema: kvs}
+	assert.Nil(t, msg.GetSchemaValue(&KeyValuePair{Key: &decodedKey, Value: &decodedValue}))
+	assert.Equal(t, int32(7), decodedKey)
+	assert.Equal(t, testAvro{ID: 100, Name: "pulsar"}, decodedValue)
+}
+
+func TestKeyValueSchemaProduceConsume(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	for _, encoding := range []KeyValueEncodingType{KeyValueEncodingInline, KeyValueEncodingSeparated} {
+		topic := newTopicName()
+		producer, err := client.CreateProducer(ProducerOptions{
+			Topic:  topic,
+			Schema: NewKeyValueSchema(NewInt32Schema(nil), NewAvroSchema(exampleSchemaDef, nil), encoding),
+		})
+		assert.Nil(t, err)
+
+		consumer, err := client.Subscribe(ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "sub-1",
+			Schema:                      NewKeyValueSchema(NewInt32Schema(nil), NewAvroSchema(exampleSchemaDef, nil), encoding),
+			SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		})
+		assert.Nil(t, err)
+
+		_, err = producer.Send(context.Background(), &ProducerMessage{
+			Value: KeyValuePair{Key: int32(7), Value: testAvro{ID: 100, Name: "pulsar"}},
+		})
+		assert.Nil(t, err)
+
+		msg, err := consumer.Receive(context.Background())
+		assert.Nil(t, err)
+
+		var key int32
+		var value testAvro
+		assert.Nil(t, msg.GetSchemaValue(&KeyValuePair{Key: &key, Value: &value}))
+		assert.Equal(t, int32(7), key)
+		assert.Equal(t, testAvro{ID: 100, Name: "pulsar"}, value)
+		if encoding == KeyValueEncodingSeparated {
+			assert.Equal(t, base64.StdEncoding.EncodeToString([]byte{7, 0, 0, 0}), msg.Key())
+		}
+
+		consumer.Close()
+		producer.Close()
+	}
+}
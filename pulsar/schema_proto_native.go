@@ -0,0 +1,228 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protoNativeSchemaData is the schema data of the PROTOBUF_NATIVE schemas, as stored by the Java client
+type protoNativeSchemaData struct {
+	FileDescriptorSet      []byte `json:"fileDescriptorSet"`
+	RootMessageTypeName    string `json:"rootMessageTypeName"`
+	RootFileDescriptorName string `json:"rootFileDescriptorName"`
+}
+
+// ProtoNativeSchema encodes and decodes protobuf messages, its schema info carries the descriptors of the
+// message and of its dependencies so that the broker can check the compatibility of the schema versions.
+type ProtoNativeSchema struct {
+	SchemaInfo
+	descriptor protoreflect.MessageDescriptor
+}
+
+// NewProtoNativeSchemaWithMessage creates the PROTOBUF_NATIVE schema of the messages of the type of message
+func NewProtoNativeSchemaWithMessage(message proto.Message, properties map[string]string) *ProtoNativeSchema {
+	return NewProtoNativeSchemaWithDescriptor(message.ProtoReflect().Descriptor(), properties)
+}
+
+// NewProtoNativeSchemaWithDescriptor creates the PROTOBUF_NATIVE schema of the messages described by the
+// descriptor, the messages can then be decoded into dynamic messages.
+func NewProtoNativeSchemaWithDescriptor(descriptor protoreflect.MessageDescriptor,
+	properties map[string]string) *ProtoNativeSchema {
+	schemaData, err := json.Marshal(protoNativeSchemaData{
+		FileDescriptorSet:      fileDescriptorSet(descriptor.ParentFile()),
+		RootMessageTypeName:    string(descriptor.FullName()),
+		RootFileDescriptorName: descriptor.ParentFile().Path(),
+	})
+	if err != nil {
+		log.Fatalf("serialize the protobuf descriptor error:%v", err)
+	}
+
+	ps := new(ProtoNativeSchema)
+	ps.descriptor = descriptor
+	ps.SchemaInfo.Schema = string(schemaData)
+	ps.SchemaInfo.Type = ProtoNative
+	ps.SchemaInfo.Properties = properties
+	ps.SchemaInfo.Name = string(descriptor.Name())
+	return ps
+}
+
+// Descriptor returns the descriptor of the messages of the schema
+func (ps *ProtoNativeSchema) Descriptor() protoreflect.MessageDescriptor {
+	return ps.descriptor
+}
+
+func (ps *ProtoNativeSchema) Encode(data interface{}) ([]byte, error) {
+	message, ok := data.(proto.Message)
+	if !ok {
+		return nil, newError(InvalidMessage, fmt.Sprintf("%T is not a protobuf message", data))
+	}
+	return proto.Marshal(message)
+}
+
+// Decode decodes the data into a protobuf message, or into a dynamic message when v is a **dynamicpb.Message
+func (ps *ProtoNativeSchema) Decode(data []byte, v interface{}) error {
+	switch m := v.(type) {
+	case **dynamicpb.Message:
+		message, err := unmarshalDynamic(ps.descriptor, data)
+		if err != nil {
+			return err
+		}
+		*m = message
+		return nil
+	case proto.Message:
+		return proto.Unmarshal(data, m)
+	}
+	return newError(InvalidMessage, fmt.Sprintf("%T is not a protobuf message", v))
+}
+
+func (ps *ProtoNativeSchema) Validate(message []byte) error {
+	var m *dynamicpb.Message
+	return ps.Decode(message, &m)
+}
+
+func (ps *ProtoNativeSchema) GetSchemaInfo() *SchemaInfo {
+	return &ps.SchemaInfo
+}
+
+// unmarshalDynamic decodes the data into a dynamic message of the descriptor
+func unmarshalDynamic(descriptor protoreflect.MessageDescriptor, data []byte) (*dynamicpb.Message, error) {
+	message := dynamicpb.NewMessage(descriptor)
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// fileDescriptorSet returns the serialized FileDescriptorSet of the file and of all its dependencies,
+// the dependencies first.
+func fileDescriptorSet(file protoreflect.FileDescriptor) []byte {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+
+	var add func(f protoreflect.FileDescriptor)
+	add = func(f protoreflect.FileDescriptor) {
+		if seen[f.Path()] {
+			return
+		}
+		seen[f.Path()] = true
+		imports := f.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(f))
+	}
+	add(file)
+
+	data, err := proto.Marshal(set)
+	if err != nil {
+		log.Fatalf("serialize the protobuf descriptor error:%v", err)
+	}
+	return data
+}
+
+// protoNativeDescriptor returns the descriptor of the root message of the schema data of a PROTOBUF_NATIVE
+// schema, as stored by the Go and the Java clients.
+func protoNativeDescriptor(schemaData string) (protoreflect.MessageDescriptor, error) {
+	var data protoNativeSchemaData
+	if err := json.Unmarshal([]byte(schemaData), &data); err != nil {
+		return nil, err
+	}
+
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data.FileDescriptorSet, &set); err != nil {
+		return nil, err
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(data.RootMessageTypeName))
+	if err != nil {
+		return nil, err
+	}
+	message, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a protobuf message", data.RootMessageTypeName)
+	}
+	return message, nil
+}
+
+// fromProtoNative converts a dynamic message to a generic record, the unset message fields are nil
+func fromProtoNative(info *SchemaInfo, message protoreflect.Message) *GenericRecord {
+	fields := message.Descriptor().Fields()
+	record := &GenericRecord{
+		schemaInfo: info,
+		fields:     make([]string, 0, fields.Len()),
+		values:     make(map[string]interface{}, fields.Len()),
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		record.fields = append(record.fields, name)
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && !message.Has(fd) {
+			record.values[name] = nil
+			continue
+		}
+		record.values[name] = fromProtoNativeValue(info, fd, message.Get(fd))
+	}
+	return record
+}
+
+func fromProtoNativeValue(info *SchemaInfo, fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		values := make([]interface{}, list.Len())
+		for i := range values {
+			values[i] = fromProtoNativeScalar(info, fd, list.Get(i))
+		}
+		return values
+	case fd.IsMap():
+		values := make(map[string]interface{})
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			values[k.String()] = fromProtoNativeScalar(info, fd.MapValue(), mv)
+			return true
+		})
+		return values
+	}
+	return fromProtoNativeScalar(info, fd, v)
+}
+
+func fromProtoNativeScalar(info *SchemaInfo, fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return fromProtoNative(info, v.Message())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	}
+	return v.Interface()
+}
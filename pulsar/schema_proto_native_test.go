@@ -0,0 +1,227 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// personDescriptor returns the descriptor of a message importing the well known Timestamp message
+func personDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label,
+		kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     kind.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/person.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Kind"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("USER"), Number: proto.Int32(0)},
+				{Name: proto.String("ADMIN"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Person"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("id", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_INT32, ""),
+				field("tags", 3, repeated, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				field("created", 4, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+					".google.protobuf.Timestamp"),
+				field("kind", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Kind"),
+			},
+		}},
+	}
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	assert.Nil(t, err)
+	return fd.Messages().ByName("Person")
+}
+
+func newPerson(descriptor protoreflect.MessageDescriptor) *dynamicpb.Message {
+	person := dynamicpb.NewMessage(descriptor)
+	fields := descriptor.Fields()
+	person.Set(fields.ByName("name"), protoreflect.ValueOfString("pulsar"))
+	person.Set(fields.ByName("id"), protoreflect.ValueOfInt32(100))
+	tags := person.Mutable(fields.ByName("tags")).List()
+	tags.Append(protoreflect.ValueOfString("a"))
+	tags.Append(protoreflect.ValueOfString("b"))
+	person.Set(fields.ByName("created"), protoreflect.ValueOfMessage(
+		(&timestamppb.Timestamp{Seconds: 10}).ProtoReflect()))
+	person.Set(fields.ByName("kind"), protoreflect.ValueOfEnum(1))
+	return person
+}
+
+func TestProtoNativeSchemaInfo(t *testing.T) {
+	descriptor := personDescriptor(t)
+	ps := NewProtoNativeSchemaWithMessage(dynamicpb.NewMessage(descriptor), nil)
+
+	info := ps.GetSchemaInfo()
+	assert.Equal(t, ProtoNative, info.Type)
+	assert.Equal(t, "Person", info.Name)
+	assert.Equal(t, descriptor, ps.Descriptor())
+
+	var data protoNativeSchemaData
+	assert.Nil(t, json.Unmarshal([]byte(info.Schema), &data))
+	assert.Equal(t, "test.Person", data.RootMessageTypeName)
+	assert.Equal(t, "test/person.proto", data.RootFileDescriptorName)
+
+	// the dependencies come first
+	var set descriptorpb.FileDescriptorSet
+	assert.Nil(t, proto.Unmarshal(data.FileDescriptorSet, &set))
+	assert.Equal(t, 2, len(set.GetFile()))
+	assert.Equal(t, "google/protobuf/timestamp.proto", set.GetFile()[0].GetName())
+	assert.Equal(t, "test/person.proto", set.GetFile()[1].GetName())
+
+	// the descriptor is rebuilt from the schema info alone
+	parsed, err := protoNativeDescriptor(info.Schema)
+	assert.Nil(t, err)
+	assert.Equal(t, protoreflect.FullName("test.Person"), parsed.FullName())
+	assert.Equal(t, 5, parsed.Fields().Len())
+}
+
+func TestProtoNativeSchemaEncodeDecode(t *testing.T) {
+	descriptor := personDescriptor(t)
+	ps := NewProtoNativeSchemaWithDescriptor(descriptor, nil)
+
+	payload, err := ps.Encode(newPerson(descriptor))
+	assert.Nil(t, err)
+	assert.Nil(t, ps.Validate(payload))
+
+	var person *dynamicpb.Message
+	assert.Nil(t, ps.Decode(payload, &person))
+	assert.Equal(t, "pulsar", person.Get(descriptor.Fields().ByName("name")).String())
+	assert.Equal(t, int64(100), person.Get(descriptor.Fields().ByName("id")).Int())
+	assert.Equal(t, 2, person.Get(descriptor.Fields().ByName("tags")).List().Len())
+
+	_, err = ps.Encode("not a message")
+	assert.NotNil(t, err)
+	var notAMessage string
+	assert.NotNil(t, ps.Decode(payload, &notAMessage))
+	assert.NotNil(t, ps.Validate([]byte{0xff}))
+
+	// generated messages
+	ts := NewProtoNativeSchemaWithMessage(&timestamppb.Timestamp{}, nil)
+	payload, err = ts.Encode(&timestamppb.Timestamp{Seconds: 10, Nanos: 5})
+	assert.Nil(t, err)
+	var decoded timestamppb.Timestamp
+	assert.Nil(t, ts.Decode(payload, &decoded))
+	assert.Equal(t, int64(10), decoded.GetSeconds())
+	assert.Equal(t, int32(5), decoded.GetNanos())
+}
+
+func TestProtoNativeGenericRecord(t *testing.T) {
+	descriptor := personDescriptor(t)
+	ps := NewProtoNativeSchemaWithDescriptor(descriptor, nil)
+	payload, err := ps.Encode(newPerson(descriptor))
+	assert.Nil(t, err)
+
+	gs, err := newGenericSchema(ps.GetSchemaInfo())
+	assert.Nil(t, err)
+	record, err := gs.decode(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name", "id", "tags", "created", "kind"}, record.Fields())
+	assert.Equal(t, "pulsar", record.Field("name"))
+	assert.Equal(t, int32(100), record.Field("id"))
+	assert.Equal(t, []interface{}{"a", "b"}, record.Field("tags"))
+	assert.Equal(t, int64(10), record.Field("created").(*GenericRecord).Field("seconds"))
+	assert.Equal(t, "ADMIN", record.Field("kind"))
+
+	// the payloads are validated when publishing with AUTO_PUBLISH
+	v, err := newPayloadValidator(ps.GetSchemaInfo())
+	assert.Nil(t, err)
+	assert.Nil(t, v.validate(payload))
+	assert.NotNil(t, v.validate([]byte{0xff}))
+}
+
+func TestProtoNativeSchema(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	descriptor := personDescriptor(t)
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewProtoNativeSchemaWithDescriptor(descriptor, nil),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewProtoNativeSchemaWithDescriptor(descriptor, nil),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	autoConsumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-2",
+		Schema:                      NewAutoConsumeSchema(),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer autoConsumer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: newPerson(descriptor),
+	})
+	assert.Nil(t, err)
+
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+	var person *dynamicpb.Message
+	assert.Nil(t, msg.GetSchemaValue(&person))
+	assert.Equal(t, "pulsar", person.Get(descriptor.Fields().ByName("name")).String())
+
+	msg, err = autoConsumer.Receive(context.Background())
+	assert.Nil(t, err)
+	var record GenericRecord
+	assert.Nil(t, msg.GetSchemaValue(&record))
+	assert.Equal(t, ProtoNative, record.SchemaType())
+	assert.Equal(t, "pulsar", record.Field("name"))
+}
@@ -0,0 +1,432 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+// structField is a field of a struct type as laid out in a record
+type structField struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+// structCodec generates the Avro definitions of Go types and converts their values to and from the goavro
+// native form, following the same rules.
+type structCodec struct {
+	// forJSON is set for the JSON schemas, whose payloads are encoded by encoding/json
+	forJSON bool
+	// names holds the full names of the records of the struct types
+	names map[reflect.Type]string
+}
+
+func newStructCodec(forJSON bool) *structCodec {
+	return &structCodec{
+		forJSON: forJSON,
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// structDefinition returns the Avro definition of the record of the struct type of v, validated by goavro
+func (c *structCodec) structDefinition(v interface{}) (reflect.Type, string, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || t == timeType {
+		return nil, "", newError(InvalidConfiguration, fmt.Sprintf("%T is not a struct", v))
+	}
+
+	definition, err := c.definition(t, t.Name())
+	if err != nil {
+		return nil, "", newError(InvalidConfiguration, fmt.Sprintf("cannot generate the schema of %s: %v", t, err))
+	}
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := goavro.NewCodec(string(data)); err != nil {
+		return nil, "", newError(InvalidConfiguration, fmt.Sprintf("invalid schema generated for %s: %v", t, err))
+	}
+	return t, string(data), nil
+}
+
+// definition returns the Avro definition of the type, the anonymous structs are named after name
+func (c *structCodec) definition(t reflect.Type, name string) (interface{}, error) {
+	switch {
+	case t == timeType:
+		if c.forJSON {
+			// encoding/json writes the times as RFC 3339 strings
+			return "string", nil
+		}
+		return map[string]interface{}{"type": "long", "logicalType": "timestamp-millis"}, nil
+	case t == bytesType:
+		return "bytes", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "int", nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return "long", nil
+	case reflect.Float32:
+		return "float", nil
+	case reflect.Float64:
+		return "double", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Ptr:
+		for t.Elem().Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		elem, err := c.definition(t.Elem(), name)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{"null", elem}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := c.definition(t.Elem(), name+"Item")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("the keys of %s are not strings", t)
+		}
+		values, err := c.definition(t.Elem(), name+"Value")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "map", "values": values}, nil
+	case reflect.Struct:
+		return c.recordDefinition(t, name)
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func (c *structCodec) recordDefinition(t reflect.Type, name string) (interface{}, error) {
+	if fullName, ok := c.names[t]; ok {
+		// already defined, or being defined for the recursive types
+		return fullName, nil
+	}
+
+	if t.Name() != "" {
+		name = t.Name()
+	}
+	namespace := avroNamespace(t.PkgPath())
+	fullName := name
+	if namespace != "" {
+		fullName = namespace + "." + name
+	}
+	c.names[t] = fullName
+
+	fields := structFields(t)
+	definitions := make([]interface{}, 0, len(fields))
+	for _, f := range fields {
+		fieldType, err := c.definition(f.typ, name+"_"+f.name)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", f.name, err)
+		}
+		field := map[string]interface{}{"name": f.name, "type": fieldType}
+		if f.typ.Kind() == reflect.Ptr {
+			field["default"] = nil
+		}
+		definitions = append(definitions, field)
+	}
+
+	record := map[string]interface{}{
+		"type":   "record",
+		"name":   name,
+		"fields": definitions,
+	}
+	if namespace != "" {
+		record["namespace"] = namespace
+	}
+	return record, nil
+}
+
+// branchName returns the name goavro gives to the union branch of the type
+func (c *structCodec) branchName(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		if c.forJSON {
+			return "string"
+		}
+		return "long.timestamp-millis"
+	case t.Kind() == reflect.Struct:
+		return c.names[t]
+	case t.Kind() == reflect.Slice && t != bytesType, t.Kind() == reflect.Array:
+		return "array"
+	case t.Kind() == reflect.Map:
+		return "map"
+	}
+	definition, _ := c.definition(t, "")
+	name, _ := definition.(string)
+	return name
+}
+
+// native converts the value to the goavro native form of its definition
+func (c *structCodec) native(v reflect.Value) interface{} {
+	t := v.Type()
+	switch {
+	case t == timeType:
+		return v.Interface()
+	case t == bytesType:
+		return v.Bytes()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return int32(v.Int())
+	case reflect.Uint8, reflect.Uint16:
+		return int32(v.Uint())
+	case reflect.Int, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Float32:
+		return float32(v.Float())
+	case reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Ptr:
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		return map[string]interface{}{c.branchName(v.Type()): c.native(v)}
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = c.native(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		values := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[iter.Key().String()] = c.native(iter.Value())
+		}
+		return values
+	case reflect.Struct:
+		fields := structFields(t)
+		record := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			record[f.name] = c.native(v.FieldByIndex(f.index))
+		}
+		return record
+	}
+	return nil
+}
+
+// fromNative sets the value from its goavro native form
+func (c *structCodec) fromNative(native interface{}, v reflect.Value) error {
+	t := v.Type()
+	switch {
+	case t == timeType:
+		tm, ok := native.(time.Time)
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		v.Set(reflect.ValueOf(tm))
+		return nil
+	case t == bytesType:
+		b, ok := native.([]byte)
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		v.SetBytes(b)
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if native == nil {
+			v.Set(reflect.Zero(t))
+			return nil
+		}
+		base := t.Elem()
+		for base.Kind() == reflect.Ptr {
+			base = base.Elem()
+		}
+		if branch, ok := native.(map[string]interface{}); ok && len(branch) == 1 {
+			if bv, ok := branch[c.branchName(base)]; ok {
+				native = bv
+			}
+		}
+		elem := reflect.New(t.Elem())
+		if err := c.fromNative(native, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Slice:
+		items, ok := native.([]interface{})
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		slice := reflect.MakeSlice(t, len(items), len(items))
+		for i, item := range items {
+			if err := c.fromNative(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Array:
+		items, ok := native.([]interface{})
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			if err := c.fromNative(items[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		values, ok := native.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		m := reflect.MakeMapWithSize(t, len(values))
+		for k, value := range values {
+			elem := reflect.New(t.Elem()).Elem()
+			if err := c.fromNative(value, elem); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), elem)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Struct:
+		record, ok := native.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot set %T to %s", native, t)
+		}
+		for _, f := range structFields(t) {
+			if err := c.fromNative(record[f.name], v.FieldByIndex(f.index)); err != nil {
+				return fmt.Errorf("field %s: %v", f.name, err)
+			}
+		}
+		return nil
+	}
+
+	value := reflect.ValueOf(native)
+	if !value.IsValid() || !value.Type().ConvertibleTo(t) {
+		return fmt.Errorf("cannot set %T to %s", native, t)
+	}
+	v.Set(value.Convert(t))
+	return nil
+}
+
+// structFields returns the exported fields of the struct type named after their avro or json tag, the fields
+// of the embedded structs are promoted as encoding/json does.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, tagged := fieldName(f)
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct {
+			for _, ef := range structFields(f.Type) {
+				ef.index = append([]int{i}, ef.index...)
+				fields = append(fields, ef)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+		fields = append(fields, structField{name: name, index: []int{i}, typ: f.Type})
+	}
+	return fields
+}
+
+// fieldName returns the name of the field given by its avro tag, or by its json tag, and whether it is tagged
+func fieldName(f reflect.StructField) (string, bool) {
+	for _, key := range []string{"avro", "json"} {
+		if tag, ok := f.Tag.Lookup(key); ok {
+			if name := strings.Split(tag, ",")[0]; name != "" {
+				return name, true
+			}
+		}
+	}
+	return f.Name, false
+}
+
+// avroNamespace returns the namespace of the records of a Go package
+func avroNamespace(pkgPath string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, path.Base(pkgPath))
+}
+
+// NewAvroSchemaFromStruct creates the Avro schema of the struct type of v, the record definition is generated
+// from the fields of the struct: the pointers are nullable and time.Time is a timestamp-millis. The fields are
+// named after their avro tag, or their json tag.
+func NewAvroSchemaFromStruct(v interface{}, properties map[string]string) (*AvroSchema, error) {
+	c := newStructCodec(false)
+	t, definition, err := c.structDefinition(v)
+	if err != nil {
+		return nil, err
+	}
+	as := NewAvroSchema(definition, properties)
+	as.structType = t
+	as.structCodec = c
+	return as, nil
+}
+
+// NewJSONSchemaFromStruct creates the JSON schema of the struct type of v, the record definition is generated
+// from the fields of the struct as for NewAvroSchemaFromStruct. The payloads are encoded by encoding/json,
+// which writes time.Time as a string.
+func NewJSONSchemaFromStruct(v interface{}, properties map[string]string) (*JSONSchema, error) {
+	_, definition, err := newStructCodec(true).structDefinition(v)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONSchema(definition, properties), nil
+}
@@ -0,0 +1,228 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type structAddress struct {
+	Street string `json:"street"`
+	Zip    *int32 `avro:"zip_code" json:"zip"`
+}
+
+type structAudit struct {
+	CreatedAt time.Time
+}
+
+type structUser struct {
+	structAudit
+	ID        int64          `json:"id"`
+	Name      string         `json:"name"`
+	Age       uint8          `json:"age"`
+	Score     float32        `json:"score"`
+	Admin     bool           `json:"admin"`
+	Avatar    []byte         `json:"avatar"`
+	Tags      []string       `json:"tags"`
+	Labels    map[string]int `json:"labels"`
+	Address   structAddress  `json:"address"`
+	Previous  *structAddress `json:"previous"`
+	Nickname  *string        `json:"nickname"`
+	LastLogin *time.Time     `json:"last_login"`
+	Extra     struct{ Note string }
+	Ignored   string `json:"-"`
+}
+
+type structNode struct {
+	Value int32
+	Next  *structNode
+}
+
+func TestStructDefinition(t *testing.T) {
+	_, definition, err := newStructCodec(false).structDefinition(&structUser{})
+	assert.Nil(t, err)
+
+	var record map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(definition), &record))
+	assert.Equal(t, "structUser", record["name"])
+	assert.Equal(t, "pulsar", record["namespace"])
+
+	types := make(map[string]interface{})
+	var names []string
+	for _, f := range record["fields"].([]interface{}) {
+		fm := f.(map[string]interface{})
+		names = append(names, fm["name"].(string))
+		types[fm["name"].(string)] = fm["type"]
+	}
+	assert.Equal(t, []string{"CreatedAt", "id", "name", "age", "score", "admin", "avatar", "tags", "labels",
+		"address", "previous", "nickname", "last_login", "Extra"}, names)
+	assert.Equal(t, map[string]interface{}{"type": "long", "logicalType": "timestamp-millis"}, types["CreatedAt"])
+	assert.Equal(t, "long", types["id"])
+	assert.Equal(t, "int", types["age"])
+	assert.Equal(t, "float", types["score"])
+	assert.Equal(t, "bytes", types["avatar"])
+	assert.Equal(t, map[string]interface{}{"type": "array", "items": "string"}, types["tags"])
+	assert.Equal(t, map[string]interface{}{"type": "map", "values": "long"}, types["labels"])
+	assert.Equal(t, []interface{}{"null", "pulsar.structAddress"}, types["previous"])
+	assert.Equal(t, []interface{}{"null", "string"}, types["nickname"])
+	assert.Equal(t, "structUser_Extra", types["Extra"].(map[string]interface{})["name"])
+
+	address := types["address"].(map[string]interface{})
+	zip := address["fields"].([]interface{})[1].(map[string]interface{})
+	assert.Equal(t, "zip_code", zip["name"])
+	assert.Nil(t, zip["default"])
+	_, hasDefault := zip["default"]
+	assert.True(t, hasDefault)
+
+	// encoding/json writes the times as strings
+	_, definition, err = newStructCodec(true).structDefinition(structUser{})
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal([]byte(definition), &record))
+	assert.Equal(t, "string", record["fields"].([]interface{})[0].(map[string]interface{})["type"])
+
+	// recursive types
+	_, definition, err = newStructCodec(false).structDefinition(structNode{})
+	assert.Nil(t, err)
+	assert.Contains(t, definition, `["null","pulsar.structNode"]`)
+
+	_, _, err = newStructCodec(false).structDefinition(1)
+	assert.NotNil(t, err)
+	_, _, err = newStructCodec(false).structDefinition(struct{ C chan int }{})
+	assert.NotNil(t, err)
+	_, _, err = newStructCodec(false).structDefinition(struct{ M map[int]string }{})
+	assert.NotNil(t, err)
+}
+
+func TestAvroSchemaFromStruct(t *testing.T) {
+	as, err := NewAvroSchemaFromStruct(&structUser{}, map[string]string{"p": "v"})
+	assert.Nil(t, err)
+	assert.Equal(t, AVRO, as.GetSchemaInfo().Type)
+	assert.Equal(t, map[string]string{"p": "v"}, as.GetSchemaInfo().Properties)
+
+	zip := int32(12345)
+	nickname := "nick"
+	lastLogin := time.Unix(1600000000, 0).UTC()
+	user := structUser{
+		structAudit: structAudit{CreatedAt: time.Unix(1500000000, 123000000).UTC()},
+		ID:          1,
+		Name:        "pulsar",
+		Age:         30,
+		Score:       1.5,
+		Admin:       true,
+		Avatar:      []byte{1, 2},
+		Tags:        []string{"a", "b"},
+		Labels:      map[string]int{"x": 1},
+		Address:     structAddress{Street: "main", Zip: &zip},
+		Previous:    &structAddress{Street: "old"},
+		Nickname:    &nickname,
+		LastLogin:   &lastLogin,
+		Ignored:     "ignored",
+	}
+	user.Extra.Note = "note"
+
+	payload, err := as.Encode(user)
+	assert.Nil(t, err)
+	var decoded structUser
+	assert.Nil(t, as.Decode(payload, &decoded))
+	user.Ignored = ""
+	assert.Equal(t, user, decoded)
+
+	// pointers to the struct are encoded as well
+	payload, err = as.Encode(&structUser{Name: "empty"})
+	assert.Nil(t, err)
+	decoded = structUser{}
+	assert.Nil(t, as.Decode(payload, &decoded))
+	assert.Equal(t, "empty", decoded.Name)
+	assert.Nil(t, decoded.Previous)
+	assert.Nil(t, decoded.Nickname)
+
+	// other types go through encoding/json
+	var generic map[string]interface{}
+	assert.Nil(t, as.Decode(payload, &generic))
+	assert.Equal(t, "empty", generic["name"])
+
+	recursive, err := NewAvroSchemaFromStruct(structNode{}, nil)
+	assert.Nil(t, err)
+	payload, err = recursive.Encode(structNode{Value: 1, Next: &structNode{Value: 2}})
+	assert.Nil(t, err)
+	var node structNode
+	assert.Nil(t, recursive.Decode(payload, &node))
+	assert.Equal(t, structNode{Value: 1, Next: &structNode{Value: 2}}, node)
+
+	_, err = NewAvroSchemaFromStruct("not a struct", nil)
+	assert.NotNil(t, err)
+}
+
+func TestJSONSchemaFromStruct(t *testing.T) {
+	js, err := NewJSONSchemaFromStruct(structAddress{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, JSON, js.GetSchemaInfo().Type)
+
+	gs, err := newGenericSchema(js.GetSchemaInfo())
+	assert.Nil(t, err)
+	zip := int32(1)
+	payload, err := js.Encode(structAddress{Street: "main", Zip: &zip})
+	assert.Nil(t, err)
+	record, err := gs.decode(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "main", record.Field("street"))
+
+	_, err = NewJSONSchemaFromStruct([]int{}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestAvroSchemaFromStructProduceConsume(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	schema, err := NewAvroSchemaFromStruct(structNode{}, nil)
+	assert.Nil(t, err)
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: schema,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      schema,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: structNode{Value: 1, Next: &structNode{Value: 2}},
+	})
+	assert.Nil(t, err)
+
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+	var node structNode
+	assert.Nil(t, msg.GetSchemaValue(&node))
+	assert.Equal(t, structNode{Value: 1, Next: &structNode{Value: 2}}, node)
+}
@@ -19,8 +19,10 @@ package pulsar
 
 import (
 	"context"
+	"encoding/hex"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/apache/pulsar-client-go/integration-tests/pb"
 	"github.com/stretchr/testify/assert"
@@ -420,3 +422,110 @@ func TestDoubleSchema(t *testing.T) {
 	assert.Equal(t, res, float64(1))
 	defer consumer.Close()
 }
+
+func TestBooleanSchema(t *testing.T) {
+	bs := NewBooleanSchema(nil)
+	assert.Equal(t, BOOLEAN, bs.GetSchemaInfo().Type)
+
+	for _, b := range []bool{true, false} {
+		data, err := bs.Encode(b)
+		assert.Nil(t, err)
+		var decoded bool
+		assert.Nil(t, bs.Decode(data, &decoded))
+		assert.Equal(t, b, decoded)
+	}
+
+	// the Java client writes a single byte
+	data, _ := bs.Encode(true)
+	assert.Equal(t, []byte{1}, data)
+	data, _ = bs.Encode(false)
+	assert.Equal(t, []byte{0}, data)
+
+	_, err := bs.Encode("true")
+	assert.NotNil(t, err)
+	var decoded bool
+	assert.NotNil(t, bs.Decode([]byte{1, 0}, &decoded))
+	assert.NotNil(t, bs.Decode([]byte{1}, new(string)))
+}
+
+func TestTimeSchemas(t *testing.T) {
+	value := time.Date(2021, 3, 4, 5, 6, 7, 89000123, time.UTC)
+	millis := time.Date(2021, 3, 4, 5, 6, 7, 89000000, time.UTC)
+
+	// the byte vectors written by the Java client
+	tests := []struct {
+		schema   *TimeSchema
+		typ      SchemaType
+		data     string
+		expected time.Time
+	}{
+		{NewDateSchema(nil), DATE, "00000177fba0fa71", millis},
+		{NewTimeSchema(nil), TIME, "00000177fba0fa71", millis},
+		{NewTimestampSchema(nil), TIMESTAMP, "00000177fba0fa71", millis},
+		{NewInstantSchema(nil), INSTANT, "0000000060406abf054e08bb", value},
+		{NewLocalDateSchema(nil), LocalDate, "0000000000004902", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{NewLocalTimeSchema(nil), LocalTime, "000010b46bd0bebb", time.Date(1, 1, 1, 5, 6, 7, 89000123, time.UTC)},
+		{NewLocalDateTimeSchema(nil), LocalDateTime, "0000000000004902000010b46bd0bebb", value},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.typ, test.schema.GetSchemaInfo().Type)
+
+		data, err := test.schema.Encode(value)
+		assert.Nil(t, err)
+		assert.Equal(t, test.data, hex.EncodeToString(data), test.schema.Name)
+
+		var decoded time.Time
+		assert.Nil(t, test.schema.Decode(data, &decoded))
+		assert.True(t, test.expected.Equal(decoded), "%s: %v", test.schema.Name, decoded)
+
+		assert.NotNil(t, test.schema.Validate(data[1:]))
+		_, err = test.schema.Encode(value.Unix())
+		assert.NotNil(t, err)
+		assert.NotNil(t, test.schema.Decode(data, new(int64)))
+	}
+
+	// the local types use the wall clock of the time
+	local := time.Date(1969, 12, 31, 23, 0, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+	data, err := NewLocalDateSchema(nil).Encode(&local)
+	assert.Nil(t, err)
+	assert.Equal(t, "ffffffffffffffff", hex.EncodeToString(data))
+	data, err = NewLocalDateTimeSchema(nil).Encode(local)
+	assert.Nil(t, err)
+	var decoded time.Time
+	assert.Nil(t, NewLocalDateTimeSchema(nil).Decode(data, &decoded))
+	assert.Equal(t, time.Date(1969, 12, 31, 23, 0, 0, 0, time.UTC), decoded)
+}
+
+func TestInstantSchema(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewInstantSchema(nil),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewInstantSchema(nil),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	value := time.Date(2021, 3, 4, 5, 6, 7, 89000123, time.UTC)
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: value,
+	})
+	assert.Nil(t, err)
+
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+	var decoded time.Time
+	assert.Nil(t, msg.GetSchemaValue(&decoded))
+	assert.True(t, value.Equal(decoded))
+}
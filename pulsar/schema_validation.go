@@ -0,0 +1,230 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// definitionValidator is implemented by the schemas whose Validate checks the payloads against the definition of
+// the schema, the payloads of these schemas are validated before sending with ProducerOptions.ValidatePayloads
+type definitionValidator interface {
+	Schema
+	validatesDefinition()
+}
+
+func (js *JSONSchema) validatesDefinition() {}
+
+func (as *AvroSchema) validatesDefinition() {}
+
+// jsonValidator checks JSON payloads against the Avro record definition of a JSON schema, only the syntax
+// of the payloads is checked without definition
+type jsonValidator struct {
+	definition interface{}
+	names      *avroNames
+}
+
+func newJSONValidator(definition string) (*jsonValidator, error) {
+	v := &jsonValidator{names: newAvroNames()}
+	if definition == "" {
+		return v, nil
+	}
+	if err := json.Unmarshal([]byte(definition), &v.definition); err != nil {
+		return nil, err
+	}
+	v.names.register(v.definition, "")
+	return v, nil
+}
+
+// validate returns an error naming the path of the first field not matching the definition
+func (v *jsonValidator) validate(payload []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("trailing data after the JSON document")
+	}
+	if v.definition == nil {
+		return nil
+	}
+	return v.check(v.definition, value, "")
+}
+
+func (v *jsonValidator) check(definition, value interface{}, path string) error {
+	definition = v.names.resolve(definition)
+
+	if union, ok := definition.([]interface{}); ok {
+		var names []string
+		for _, branch := range union {
+			if v.check(branch, value, path) == nil {
+				return nil
+			}
+			names = append(names, v.names.branchName(branch))
+		}
+		return pathError(path, "%s does not match any of the types %v", describeJSON(value), names)
+	}
+
+	typeName := v.names.typeName(definition)
+	switch typeName {
+	case "null":
+		if value != nil {
+			return pathError(path, "expected null, got %s", describeJSON(value))
+		}
+		return nil
+	case "array", "map", "bytes":
+		// encoding/json writes the nil slices and maps as null
+		if value == nil {
+			return nil
+		}
+	}
+
+	switch typeName {
+	case "boolean":
+		if _, ok := value.(bool); ok {
+			return nil
+		}
+	case "int", "long":
+		if n, ok := value.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				return pathError(path, "expected %s, got %s", typeName, n)
+			}
+			if typeName == "int" && (i < math.MinInt32 || i > math.MaxInt32) {
+				return pathError(path, "%d overflows int", i)
+			}
+			return nil
+		}
+	case "float", "double":
+		if _, ok := value.(json.Number); ok {
+			return nil
+		}
+	case "string", "bytes", "fixed":
+		if _, ok := value.(string); ok {
+			return nil
+		}
+	case "enum":
+		if s, ok := value.(string); ok {
+			symbols, _ := definition.(map[string]interface{})["symbols"].([]interface{})
+			for _, symbol := range symbols {
+				if symbol == s {
+					return nil
+				}
+			}
+			return pathError(path, "unknown enum symbol %q", s)
+		}
+	case "array":
+		if items, ok := value.([]interface{}); ok {
+			itemsDefinition := definition.(map[string]interface{})["items"]
+			for i, item := range items {
+				if err := v.check(itemsDefinition, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case "map":
+		if values, ok := value.(map[string]interface{}); ok {
+			valuesDefinition := definition.(map[string]interface{})["values"]
+			for _, k := range sortedKeys(values) {
+				if err := v.check(valuesDefinition, values[k], fieldPath(path, k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case "record":
+		if record, ok := value.(map[string]interface{}); ok {
+			return v.checkRecord(definition.(map[string]interface{}), record, path)
+		}
+	default:
+		return pathError(path, "unsupported type %s", typeName)
+	}
+	return pathError(path, "expected %s, got %s", typeName, describeJSON(value))
+}
+
+// checkRecord checks the fields of the record, the fields unknown to the definition are allowed
+func (v *jsonValidator) checkRecord(definition, record map[string]interface{}, path string) error {
+	fields, _ := definition["fields"].([]interface{})
+	for _, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := field["name"].(string)
+		value, present := record[name]
+		if !present {
+			if _, hasDefault := field["default"]; hasDefault || v.check(field["type"], nil, "") == nil {
+				continue
+			}
+			return pathError(fieldPath(path, name), "missing required field")
+		}
+		if err := v.check(field["type"], value, fieldPath(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func pathError(path, format string, args ...interface{}) error {
+	if path == "" {
+		return fmt.Errorf(format, args...)
+	}
+	return fmt.Errorf("field %s: %s", path, fmt.Sprintf(format, args...))
+}
+
+func describeJSON(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number " + v.String()
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", value), "*")
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
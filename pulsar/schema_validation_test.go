@@ -0,0 +1,124 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const validationSchemaDef = `{"type":"record","name":"User","namespace":"test","fields":[` +
+	`{"name":"id","type":"int"},` +
+	`{"name":"name","type":"string"},` +
+	`{"name":"email","type":["null","string"]},` +
+	`{"name":"score","type":"double","default":0},` +
+	`{"name":"kind","type":{"type":"enum","name":"Kind","symbols":["USER","ADMIN"]},"default":"USER"},` +
+	`{"name":"tags","type":{"type":"array","items":"string"},"default":[]},` +
+	`{"name":"address","type":["null",{"type":"record","name":"Address","fields":[` +
+	`{"name":"zip","type":"long"}]}],"default":null},` +
+	`{"name":"labels","type":{"type":"map","values":"Address"},"default":{}}]}`
+
+func TestJSONSchemaValidate(t *testing.T) {
+	js := NewJSONSchema(validationSchemaDef, nil)
+
+	valid := []string{
+		`{"id":1,"name":"pulsar"}`,
+		`{"id":1,"name":"pulsar","email":null,"score":1.5,"kind":"ADMIN","tags":["a"],"extra":true}`,
+		`{"id":1,"name":"pulsar","email":"a@b.c","address":{"zip":123},"labels":{"home":{"zip":1}}}`,
+		`{"id":1,"name":"pulsar","tags":null}`,
+	}
+	for _, payload := range valid {
+		assert.Nil(t, js.Validate([]byte(payload)), payload)
+	}
+
+	invalid := map[string]string{
+		`{"id":1`:                                "invalid JSON payload: unexpected EOF",
+		`{"id":1,"name":"a"} {}`:                 "invalid JSON payload: trailing data after the JSON document",
+		`[]`:                                     "invalid JSON payload: expected record, got array",
+		`{"name":"pulsar"}`:                      "invalid JSON payload: field id: missing required field",
+		`{"id":"1","name":"pulsar"}`:             "invalid JSON payload: field id: expected int, got string",
+		`{"id":1.5,"name":"pulsar"}`:             "invalid JSON payload: field id: expected int, got 1.5",
+		`{"id":3000000000,"name":"pulsar"}`:      "invalid JSON payload: field id: 3000000000 overflows int",
+		`{"id":1,"name":"pulsar","kind":"ROOT"}`: "invalid JSON payload: field kind: unknown enum symbol \"ROOT\"",
+		`{"id":1,"name":"pulsar","tags":[1]}`:    "invalid JSON payload: field tags[0]: expected string, got number 1",
+		`{"id":1,"name":"pulsar","email":1}`: "invalid JSON payload: field email: number 1 does not match " +
+			"any of the types [null string]",
+		`{"id":1,"name":"pulsar","labels":{"home":{"zip":"x"}}}`: "invalid JSON payload: field labels.home.zip: " +
+			"expected long, got string",
+	}
+	for payload, message := range invalid {
+		err := js.Validate([]byte(payload))
+		if assert.NotNil(t, err, payload) {
+			assert.Equal(t, InvalidMessage, err.(*Error).Result())
+			assert.Equal(t, message+": InvalidMessage", err.Error(), payload)
+		}
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	as := NewAvroSchema(exampleSchemaDef, nil)
+	payload, err := as.Encode(testAvro{ID: 1, Name: "pulsar"})
+	assert.Nil(t, err)
+	assert.Nil(t, as.Validate(payload))
+	assert.NotNil(t, as.Validate(payload[:len(payload)-1]))
+	assert.NotNil(t, as.Validate(append(payload, 0)))
+}
+
+func TestProducerValidatePayloads(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:            topic,
+		Schema:           NewJSONSchema(validationSchemaDef, nil),
+		ValidatePayloads: true,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewJSONSchema(validationSchemaDef, nil),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Payload: []byte(`{"id":"1","name":"pulsar"}`),
+	})
+	assert.NotNil(t, err)
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: map[string]interface{}{"name": "pulsar"},
+	})
+	assert.NotNil(t, err)
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: map[string]interface{}{"id": 1, "name": "pulsar"},
+	})
+	assert.Nil(t, err)
+
+	// only the valid message reaches the topic
+	msg, err := consumer.Receive(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":1,"name":"pulsar"}`, string(msg.Payload()))
+}
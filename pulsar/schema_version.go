@@ -0,0 +1,457 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// schemaInfoCache fetches the schemas of a topic by version, each version once
+type schemaInfoCache struct {
+	fetch func(schemaVersion []byte) (*SchemaInfo, error)
+
+	lock  sync.Mutex
+	infos map[string]*SchemaInfo
+}
+
+func newSchemaInfoCache(fetch func(schemaVersion []byte) (*SchemaInfo, error)) *schemaInfoCache {
+	return &schemaInfoCache{
+		fetch: fetch,
+		infos: make(map[string]*SchemaInfo),
+	}
+}
+
+// get returns the schema with the given version, the latest schema, which is not cached, when nil
+func (c *schemaInfoCache) get(schemaVersion []byte) (*SchemaInfo, error) {
+	if len(schemaVersion) == 0 {
+		return c.fetch(nil)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if info, ok := c.infos[string(schemaVersion)]; ok {
+		return info, nil
+	}
+	info, err := c.fetch(schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	c.infos[string(schemaVersion)] = info
+	return info, nil
+}
+
+// avroWriterSchema is a writer schema of the messages decoded by an AvroSchema
+type avroWriterSchema struct {
+	codec      *goavro.Codec
+	definition interface{}
+	names      *avroNames
+}
+
+func newAvroWriterSchema(schema string) (*avroWriterSchema, error) {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+	ws := &avroWriterSchema{
+		codec: codec,
+		names: newAvroNames(),
+	}
+	if err := json.Unmarshal([]byte(schema), &ws.definition); err != nil {
+		return nil, err
+	}
+	ws.names.register(ws.definition, "")
+	return ws, nil
+}
+
+// decodeMessage decodes the message with the schema it was produced with, the writer schema, rather than only
+// with the schema of the consumer
+func (as *AvroSchema) decodeMessage(msg *message, v interface{}) error {
+	if len(msg.schemaVersion) == 0 || msg.schemaInfoCache == nil {
+		return as.Decode(msg.payLoad, v)
+	}
+	writer, err := msg.schemaInfoCache.get(msg.schemaVersion)
+	if err != nil {
+		return err
+	}
+	return as.decodeWithWriterSchema(writer, msg.payLoad, v)
+}
+
+// decodeWithWriterSchema decodes the data written with the writer schema, resolving it against the schema
+// of the AvroSchema as described by the Avro specification: the fields are matched by name or alias, the
+// fields unknown to the writer get their default value and the numbers are promoted.
+func (as *AvroSchema) decodeWithWriterSchema(writer *SchemaInfo, data []byte, v interface{}) error {
+	if writer == nil || writer.Type != AVRO {
+		return as.Decode(data, v)
+	}
+
+	ws, reader, err := as.writerSchema(writer.Schema)
+	if err != nil {
+		return err
+	}
+	if ws.codec.Schema() == as.Codec.Schema() {
+		return as.Decode(data, v)
+	}
+
+	native, _, err := ws.codec.NativeFromBinary(data)
+	if err != nil {
+		return err
+	}
+	r := &avroResolver{writer: ws.names, reader: reader.names}
+	resolved, err := r.resolve(ws.definition, reader.definition, native)
+	if err != nil {
+		return err
+	}
+	return as.fromNative(resolved, v)
+}
+
+// writerSchema returns the parsed writer schema, along with the parsed schema of the AvroSchema
+func (as *AvroSchema) writerSchema(schema string) (*avroWriterSchema, *avroWriterSchema, error) {
+	as.writersLock.Lock()
+	defer as.writersLock.Unlock()
+
+	if as.writers == nil {
+		reader, err := newAvroWriterSchema(as.Codec.Schema())
+		if err != nil {
+			return nil, nil, err
+		}
+		as.reader = reader
+		as.writers = make(map[string]*avroWriterSchema)
+	}
+	if ws, ok := as.writers[schema]; ok {
+		return ws, as.reader, nil
+	}
+	ws, err := newAvroWriterSchema(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	as.writers[schema] = ws
+	return ws, as.reader, nil
+}
+
+// avroResolver converts data of the goavro native form from a writer definition to a reader definition
+type avroResolver struct {
+	writer *avroNames
+	reader *avroNames
+}
+
+func (r *avroResolver) resolve(writer, reader, value interface{}) (interface{}, error) {
+	writer = r.writer.resolve(writer)
+	reader = r.reader.resolve(reader)
+
+	if writerUnion, ok := writer.([]interface{}); ok {
+		// resolve the branch which was written
+		if value == nil {
+			return r.resolve("null", reader, nil)
+		}
+		branchValue, ok := value.(map[string]interface{})
+		if !ok || len(branchValue) != 1 {
+			return nil, fmt.Errorf("invalid avro union value %v", value)
+		}
+		for name, v := range branchValue {
+			for _, branch := range writerUnion {
+				if r.writer.branchName(branch) == name {
+					return r.resolve(branch, reader, v)
+				}
+			}
+			return nil, fmt.Errorf("unknown avro union branch %s", name)
+		}
+	}
+
+	if readerUnion, ok := reader.([]interface{}); ok {
+		// the first branch matching the writer type
+		for _, branch := range readerUnion {
+			if !r.matches(writer, branch) {
+				continue
+			}
+			resolved, err := r.resolve(writer, branch, value)
+			if err != nil || resolved == nil {
+				return resolved, err
+			}
+			return map[string]interface{}{r.reader.branchName(branch): resolved}, nil
+		}
+		return nil, fmt.Errorf("no branch of the reader union matches the writer type %s", r.writer.typeName(writer))
+	}
+
+	writerType, readerType := r.writer.typeName(writer), r.reader.typeName(reader)
+	switch {
+	case writerType == "record" && readerType == "record":
+		return r.resolveRecord(writer.(map[string]interface{}), reader.(map[string]interface{}), value)
+	case writerType == "enum" && readerType == "enum":
+		return r.resolveEnum(reader.(map[string]interface{}), value)
+	case writerType == "array" && readerType == "array":
+		items, _ := value.([]interface{})
+		resolved := make([]interface{}, len(items))
+		for i, item := range items {
+			var err error
+			if resolved[i], err = r.resolve(writer.(map[string]interface{})["items"],
+				reader.(map[string]interface{})["items"], item); err != nil {
+				return nil, err
+			}
+		}
+		return resolved, nil
+	case writerType == "map" && readerType == "map":
+		values, _ := value.(map[string]interface{})
+		resolved := make(map[string]interface{}, len(values))
+		for k, v := range values {
+			var err error
+			if resolved[k], err = r.resolve(writer.(map[string]interface{})["values"],
+				reader.(map[string]interface{})["values"], v); err != nil {
+				return nil, err
+			}
+		}
+		return resolved, nil
+	case writerType == readerType:
+		return value, nil
+	}
+	return promote(writerType, readerType, value)
+}
+
+// matches returns true when data of the writer type can be read as the reader type
+func (r *avroResolver) matches(writer, reader interface{}) bool {
+	writerType, readerType := r.writer.typeName(writer), r.reader.typeName(reader)
+	switch writerType {
+	case "record", "enum", "fixed":
+		if writerType != readerType {
+			return false
+		}
+		// the named types match by name
+		writerName := r.writer.fullName(r.writer.resolve(writer).(map[string]interface{}))
+		readerName := r.reader.fullName(r.reader.resolve(reader).(map[string]interface{}))
+		return writerName == readerName || shortName(writerName) == shortName(readerName)
+	}
+	if writerType == readerType {
+		return true
+	}
+	_, err := promote(writerType, readerType, zeroAvroValue(writerType))
+	return err == nil
+}
+
+func (r *avroResolver) resolveRecord(writer, reader map[string]interface{}, value interface{}) (interface{}, error) {
+	record, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid avro record value %v", value)
+	}
+
+	writerFields := make(map[string]map[string]interface{})
+	if fields, ok := writer["fields"].([]interface{}); ok {
+		for _, f := range fields {
+			if fm, ok := f.(map[string]interface{}); ok {
+				name, _ := fm["name"].(string)
+				writerFields[name] = fm
+			}
+		}
+	}
+
+	resolved := make(map[string]interface{})
+	fields, _ := reader["fields"].([]interface{})
+	for _, f := range fields {
+		rf, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := rf["name"].(string)
+
+		wf, found := writerFields[name]
+		if !found {
+			aliases, _ := rf["aliases"].([]interface{})
+			for _, alias := range aliases {
+				if a, ok := alias.(string); ok {
+					if wf, found = writerFields[a]; found {
+						break
+					}
+				}
+			}
+		}
+
+		if found {
+			wfName, _ := wf["name"].(string)
+			v, err := r.resolve(wf["type"], rf["type"], record[wfName])
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %v", name, err)
+			}
+			resolved[name] = v
+			continue
+		}
+
+		defaultValue, ok := rf["default"]
+		if !ok {
+			return nil, fmt.Errorf("field %s is missing from the writer schema and has no default", name)
+		}
+		v, err := r.defaultValue(rf["type"], defaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", name, err)
+		}
+		resolved[name] = v
+	}
+	return resolved, nil
+}
+
+func (r *avroResolver) resolveEnum(reader map[string]interface{}, value interface{}) (interface{}, error) {
+	symbols, _ := reader["symbols"].([]interface{})
+	for _, s := range symbols {
+		if s == value {
+			return value, nil
+		}
+	}
+	if d, ok := reader["default"]; ok {
+		return d, nil
+	}
+	return nil, fmt.Errorf("unknown enum symbol %v", value)
+}
+
+// defaultValue converts the JSON default value of a reader field to the goavro native form
+func (r *avroResolver) defaultValue(definition, value interface{}) (interface{}, error) {
+	definition = r.reader.resolve(definition)
+	if union, ok := definition.([]interface{}); ok {
+		// the default value of a union is of its first branch
+		if len(union) == 0 || r.reader.typeName(union[0]) == "null" {
+			return nil, nil
+		}
+		v, err := r.defaultValue(union[0], value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{r.reader.branchName(union[0]): v}, nil
+	}
+
+	switch r.reader.typeName(definition) {
+	case "null":
+		return nil, nil
+	case "int":
+		if f, ok := value.(float64); ok {
+			return int32(f), nil
+		}
+	case "long":
+		if f, ok := value.(float64); ok {
+			return int64(f), nil
+		}
+	case "float":
+		if f, ok := value.(float64); ok {
+			return float32(f), nil
+		}
+	case "bytes", "fixed":
+		if s, ok := value.(string); ok {
+			return []byte(s), nil
+		}
+	case "record":
+		m, _ := value.(map[string]interface{})
+		record := make(map[string]interface{})
+		fields, _ := definition.(map[string]interface{})["fields"].([]interface{})
+		for _, f := range fields {
+			fm, _ := f.(map[string]interface{})
+			name, _ := fm["name"].(string)
+			fieldValue, ok := m[name]
+			if !ok {
+				fieldValue = fm["default"]
+			}
+			v, err := r.defaultValue(fm["type"], fieldValue)
+			if err != nil {
+				return nil, err
+			}
+			record[name] = v
+		}
+		return record, nil
+	case "array":
+		items, _ := value.([]interface{})
+		converted := make([]interface{}, len(items))
+		for i, item := range items {
+			v, err := r.defaultValue(definition.(map[string]interface{})["items"], item)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = v
+		}
+		return converted, nil
+	case "map":
+		values, _ := value.(map[string]interface{})
+		converted := make(map[string]interface{}, len(values))
+		for k, item := range values {
+			v, err := r.defaultValue(definition.(map[string]interface{})["values"], item)
+			if err != nil {
+				return nil, err
+			}
+			converted[k] = v
+		}
+		return converted, nil
+	}
+	return value, nil
+}
+
+// promote converts the value of the writer type to the reader type, following the promotion rules of Avro
+func promote(writerType, readerType string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int32:
+		switch readerType {
+		case "long":
+			return int64(v), nil
+		case "float":
+			return float32(v), nil
+		case "double":
+			return float64(v), nil
+		}
+	case int64:
+		switch readerType {
+		case "float":
+			return float32(v), nil
+		case "double":
+			return float64(v), nil
+		}
+	case float32:
+		if readerType == "double" {
+			return float64(v), nil
+		}
+	case string:
+		if readerType == "bytes" {
+			return []byte(v), nil
+		}
+	case []byte:
+		if readerType == "string" {
+			return string(v), nil
+		}
+	}
+	return nil, fmt.Errorf("the writer type %s cannot be read as %s", writerType, readerType)
+}
+
+func zeroAvroValue(avroType string) interface{} {
+	switch avroType {
+	case "int":
+		return int32(0)
+	case "long":
+		return int64(0)
+	case "float":
+		return float32(0)
+	case "string":
+		return ""
+	case "bytes":
+		return []byte{}
+	}
+	return nil
+}
+
+func shortName(fullName string) string {
+	for i := len(fullName) - 1; i >= 0; i-- {
+		if fullName[i] == '.' {
+			return fullName[i+1:]
+		}
+	}
+	return fullName
+}
@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const evolvedSchemaDef = `{"type":"record","name":"Example","namespace":"test","fields":[` +
+	`{"name":"ID","type":"long"},` +
+	`{"name":"FullName","type":"string","aliases":["Name"]},` +
+	`{"name":"Email","type":["null","string"],"default":null},` +
+	`{"name":"Age","type":"int","default":18},` +
+	`{"name":"Tags","type":{"type":"array","items":"string"},"default":["new"]}]}`
+
+type evolvedAvro struct {
+	ID       int64
+	FullName string
+	Email    *string
+	Age      int
+	Tags     []string
+}
+
+func TestAvroSchemaWriterSchema(t *testing.T) {
+	writer := NewAvroSchema(exampleSchemaDef, nil)
+	payload, err := writer.Encode(testAvro{ID: 100, Name: "pulsar"})
+	assert.Nil(t, err)
+
+	var fetched [][]byte
+	cache := newSchemaInfoCache(func(schemaVersion []byte) (*SchemaInfo, error) {
+		fetched = append(fetched, schemaVersion)
+		return writer.GetSchemaInfo(), nil
+	})
+
+	reader := NewAvroSchema(evolvedSchemaDef, nil)
+	msg := &message{payLoad: payload, schema: reader, schemaVersion: []byte{0, 0, 0, 0, 0, 0, 0, 0},
+		schemaInfoCache: cache}
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0}, msg.SchemaVersion())
+
+	var v evolvedAvro
+	assert.Nil(t, msg.GetSchemaValue(&v))
+	assert.Equal(t, evolvedAvro{ID: 100, FullName: "pulsar", Age: 18, Tags: []string{"new"}}, v)
+
+	// the writer schemas are fetched once
+	assert.Nil(t, msg.GetSchemaValue(&v))
+	assert.Equal(t, 1, len(fetched))
+
+	// the messages written with the schema of the consumer are decoded as usual
+	payload, err = reader.Encode(evolvedAvro{ID: 1, FullName: "name", Age: 30, Tags: []string{"go"}})
+	assert.Nil(t, err)
+	v = evolvedAvro{}
+	assert.Nil(t, reader.decodeWithWriterSchema(reader.GetSchemaInfo(), payload, &v))
+	assert.Equal(t, evolvedAvro{ID: 1, FullName: "name", Age: 30, Tags: []string{"go"}}, v)
+
+	// a reader field which is unknown to the writer must have a default
+	strict := NewAvroSchema(`{"type":"record","name":"Example","namespace":"test","fields":[`+
+		`{"name":"ID","type":"int"},{"name":"Age","type":"int"}]}`, nil)
+	payload, err = writer.Encode(testAvro{ID: 100, Name: "pulsar"})
+	assert.Nil(t, err)
+	assert.NotNil(t, strict.decodeWithWriterSchema(writer.GetSchemaInfo(), payload, &v))
+
+	// a long cannot be read as an int
+	assert.NotNil(t, writer.decodeWithWriterSchema(reader.GetSchemaInfo(), payload, &v))
+}
+
+func TestAvroSchemaEvolution(t *testing.T) {
+	client := createClient()
+	defer client.Close()
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewAvroSchema(exampleSchemaDef, nil),
+	})
+	assert.Nil(t, err)
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: testAvro{ID: 100, Name: "pulsar"},
+	})
+	assert.Nil(t, err)
+	producer.Close()
+
+	producer, err = client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewAvroSchema(evolvedSchemaDef, nil),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Value: evolvedAvro{ID: 200, FullName: "client", Age: 30, Tags: []string{"go"}},
+	})
+	assert.Nil(t, err)
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub-1",
+		Schema:                      NewAvroSchema(evolvedSchemaDef, nil),
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	expected := []evolvedAvro{
+		{ID: 100, FullName: "pulsar", Age: 18, Tags: []string{"new"}},
+		{ID: 200, FullName: "client", Age: 30, Tags: []string{"go"}},
+	}
+	var versions [][]byte
+	for _, e := range expected {
+		msg, err := consumer.Receive(context.Background())
+		assert.Nil(t, err)
+		versions = append(versions, msg.(SchemaVersionMessage).SchemaVersion())
+
+		var v evolvedAvro
+		assert.Nil(t, msg.GetSchemaValue(&v))
+		assert.Equal(t, e, v)
+	}
+	assert.NotEqual(t, versions[0], versions[1])
+}
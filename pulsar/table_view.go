@@ -0,0 +1,74 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"reflect"
+	"time"
+)
+
+// TableViewOptions contains the options for creating a TableView
+type TableViewOptions struct {
+	// Topic specify the compacted topic the TableView is built from, it can be partitioned.
+	// This argument is required when constructing the TableView.
+	Topic string
+
+	// Schema decodes the message payloads into values, when not set the values are the raw payloads as []byte.
+	Schema Schema
+
+	// SchemaValueType is the type the payloads are decoded into with the Schema, eg. `reflect.TypeOf(myStruct{})`.
+	// The values of the TableView are of this type. It is required when a Schema is set.
+	SchemaValueType reflect.Type
+
+	// AutoDiscoveryPeriod sets the interval at which the TableView looks for new partitions of the topic.
+	// Default is 1 minute.
+	AutoDiscoveryPeriod time.Duration
+}
+
+// TableView provides a key/value view over the latest value of each key of a compacted topic.
+// The view is first loaded with all the messages of the topic, then kept up to date by reading
+// the new messages as they are published. A message with an empty payload deletes its key.
+type TableView interface {
+	// Size returns the number of keys in the TableView
+	Size() int
+
+	// IsEmpty returns true if the TableView has no keys
+	IsEmpty() bool
+
+	// ContainsKey returns true if the TableView has a value for the key
+	ContainsKey(key string) bool
+
+	// Get returns the value of the key, or nil if the key is not in the TableView
+	Get(key string) interface{}
+
+	// Keys returns all the keys of the TableView
+	Keys() []string
+
+	// Entries returns a copy of all the key/value pairs of the TableView
+	Entries() map[string]interface{}
+
+	// ForEach calls the action for each key/value pair of the TableView, stopping at the first error
+	ForEach(action func(key string, value interface{}) error) error
+
+	// ForEachAndListen calls the action for each key/value pair of the TableView, then keeps calling it
+	// every time a key is updated. The value is nil when the key is deleted.
+	ForEachAndListen(action func(key string, value interface{}) error) error
+
+	// Close closes the TableView and stops reading from the topic
+	Close()
+}
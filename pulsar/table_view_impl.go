@@ -0,0 +1,221 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar/log"
+)
+
+type tableView struct {
+	client  *client
+	options TableViewOptions
+	reader  Reader
+
+	dataLock  sync.RWMutex
+	data      map[string]interface{}
+	listeners []func(string, interface{}) error
+
+	cancel  context.CancelFunc
+	closeWg sync.WaitGroup
+	log     log.Logger
+}
+
+func newTableView(client *client, options TableViewOptions) (TableView, error) {
+	if options.Topic == "" {
+		return nil, newError(InvalidConfiguration, "Topic is required")
+	}
+
+	if options.Schema != nil && options.SchemaValueType == nil {
+		return nil, newError(InvalidConfiguration, "SchemaValueType is required when a Schema is set")
+	}
+
+	reader, err := newReader(client, ReaderOptions{
+		Topic:               options.Topic,
+		StartMessageID:      EarliestMessageID(),
+		ReadCompacted:       true,
+		AutoDiscoveryPeriod: options.AutoDiscoveryPeriod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tv := &tableView{
+		client:  client,
+		options: options,
+		reader:  reader,
+		data:    make(map[string]interface{}),
+		log:     client.log.SubLogger(log.Fields{"topic": options.Topic}),
+	}
+
+	// load the current content of the topic before returning the view
+	ctx, cancel := context.WithCancel(context.Background())
+	tv.cancel = cancel
+	for reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		if err != nil {
+			cancel()
+			reader.Close()
+			return nil, err
+		}
+		tv.handleMessage(msg)
+	}
+
+	tv.closeWg.Add(1)
+	go tv.watchForUpdates(ctx)
+
+	return tv, nil
+}
+
+func (tv *tableView) watchForUpdates(ctx context.Context) {
+	defer tv.closeWg.Done()
+	for {
+		msg, err := tv.reader.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				tv.log.WithError(err).Error("Failed to read the next message, stop updating the table view")
+			}
+			return
+		}
+		tv.handleMessage(msg)
+	}
+}
+
+func (tv *tableView) handleMessage(msg Message) {
+	key := msg.Key()
+	if key == "" {
+		tv.log.Debugf("Ignoring message without key messageID=%+v", msg.ID())
+		return
+	}
+
+	var value interface{}
+	if len(msg.Payload()) > 0 {
+		var err error
+		if value, err = tv.decode(msg.Payload()); err != nil {
+			tv.log.WithError(err).Errorf("Failed to decode the value of key=%s messageID=%+v", key, msg.ID())
+			return
+		}
+	}
+
+	tv.dataLock.Lock()
+	if value == nil {
+		delete(tv.data, key)
+	} else {
+		tv.data[key] = value
+	}
+	listeners := tv.listeners
+	tv.dataLock.Unlock()
+
+	for _, listener := range listeners {
+		if err := listener(key, value); err != nil {
+			tv.log.WithError(err).Errorf("Listener failed to handle the update of key=%s", key)
+		}
+	}
+}
+
+func (tv *tableView) decode(payload []byte) (interface{}, error) {
+	if tv.options.Schema == nil {
+		return payload, nil
+	}
+
+	v := reflect.New(tv.options.SchemaValueType)
+	if err := tv.options.Schema.Decode(payload, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+func (tv *tableView) Size() int {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	return len(tv.data)
+}
+
+func (tv *tableView) IsEmpty() bool {
+	return tv.Size() == 0
+}
+
+func (tv *tableView) ContainsKey(key string) bool {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	_, ok := tv.data[key]
+	return ok
+}
+
+func (tv *tableView) Get(key string) interface{} {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	return tv.data[key]
+}
+
+func (tv *tableView) Keys() []string {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	keys := make([]string, 0, len(tv.data))
+	for k := range tv.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (tv *tableView) Entries() map[string]interface{} {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	entries := make(map[string]interface{}, len(tv.data))
+	for k, v := range tv.data {
+		entries[k] = v
+	}
+	return entries
+}
+
+func (tv *tableView) ForEach(action func(string, interface{}) error) error {
+	tv.dataLock.RLock()
+	defer tv.dataLock.RUnlock()
+	for k, v := range tv.data {
+		if err := action(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tv *tableView) ForEachAndListen(action func(string, interface{}) error) error {
+	tv.dataLock.Lock()
+	defer tv.dataLock.Unlock()
+	for k, v := range tv.data {
+		if err := action(k, v); err != nil {
+			return err
+		}
+	}
+
+	// copy on write so that handleMessage can call the listeners without holding the lock
+	listeners := make([]func(string, interface{}) error, len(tv.listeners), len(tv.listeners)+1)
+	copy(listeners, tv.listeners)
+	tv.listeners = append(listeners, action)
+	return nil
+}
+
+func (tv *tableView) Close() {
+	tv.cancel()
+	tv.reader.Close()
+	tv.closeWg.Wait()
+	tv.client.handlers.Del(tv)
+}
@@ -0,0 +1,145 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableViewConfigErrors(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	tv, err := client.CreateTableView(TableViewOptions{})
+	assert.Nil(t, tv)
+	assert.NotNil(t, err)
+
+	tv, err = client.CreateTableView(TableViewOptions{
+		Topic:  newTopicName(),
+		Schema: NewJSONSchema(exampleSchemaDef, nil),
+	})
+	assert.Nil(t, tv)
+	assert.NotNil(t, err)
+}
+
+func TestTableView(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	testURL := adminURL + "/" + "admin/v2/persistent/public/default/" + topic + "/partitions"
+	makeHTTPCall(t, http.MethodPut, testURL, "3")
+	ctx := context.Background()
+
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic: topic,
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	const N = 10
+	for i := 0; i < N; i++ {
+		_, err := producer.Send(ctx, &ProducerMessage{
+			Key:     fmt.Sprintf("key-%d", i),
+			Payload: []byte(fmt.Sprintf("value-%d", i)),
+		})
+		assert.NoError(t, err)
+	}
+
+	tv, err := client.CreateTableView(TableViewOptions{
+		Topic: topic,
+	})
+	assert.Nil(t, err)
+	defer tv.Close()
+
+	assert.Equal(t, N, tv.Size())
+	assert.Equal(t, []byte("value-3"), tv.Get("key-3"))
+	assert.Equal(t, N, len(tv.Keys()))
+
+	var lock sync.Mutex
+	updates := make(map[string]interface{})
+	err = tv.ForEachAndListen(func(key string, value interface{}) error {
+		lock.Lock()
+		defer lock.Unlock()
+		updates[key] = value
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// update a key and delete another one
+	_, err = producer.Send(ctx, &ProducerMessage{Key: "key-1", Payload: []byte("updated")})
+	assert.NoError(t, err)
+	_, err = producer.Send(ctx, &ProducerMessage{Key: "key-2"})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return tv.Size() == N-1 && string(tv.Get("key-1").([]byte)) == "updated"
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.False(t, tv.ContainsKey("key-2"))
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, []byte("updated"), updates["key-1"])
+	assert.Nil(t, updates["key-2"])
+}
+
+func TestTableViewSchema(t *testing.T) {
+	client, err := NewClient(ClientOptions{
+		URL: lookupURL,
+	})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	topic := newTopicName()
+	producer, err := client.CreateProducer(ProducerOptions{
+		Topic:  topic,
+		Schema: NewJSONSchema(exampleSchemaDef, nil),
+	})
+	assert.Nil(t, err)
+	defer producer.Close()
+
+	_, err = producer.Send(context.Background(), &ProducerMessage{
+		Key:   "pulsar",
+		Value: &testJSON{ID: 100, Name: "pulsar"},
+	})
+	assert.NoError(t, err)
+
+	tv, err := client.CreateTableView(TableViewOptions{
+		Topic:           topic,
+		Schema:          NewJSONSchema(exampleSchemaDef, nil),
+		SchemaValueType: reflect.TypeOf(testJSON{}),
+	})
+	assert.Nil(t, err)
+	defer tv.Close()
+
+	assert.Equal(t, testJSON{ID: 100, Name: "pulsar"}, tv.Get("pulsar"))
+}
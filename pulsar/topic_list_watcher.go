@@ -0,0 +1,313 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+	pb "github.com/apache/pulsar-client-go/pulsar/internal/pulsar_proto"
+	"github.com/apache/pulsar-client-go/pulsar/log"
+)
+
+var errTopicWatcherNotSupported = errors.New("broker does not support topic list watchers")
+
+// topicListWatcher keeps track of the persistent topics of a namespace matching a pattern,
+// using the topic additions and removals pushed by the broker instead of polling for them.
+type topicListWatcher struct {
+	client    *client
+	namespace string
+	// topic used to lookup the broker serving the watcher
+	lookupTopic string
+	pattern     *regexp.Regexp
+	watcherID   uint64
+
+	// topics holds the matching topics as reported by the broker, partitions included
+	topics map[string]struct{}
+	hash   string
+
+	cnxLock sync.Mutex
+	cnx     internal.Connection
+
+	// onChange is called with the complete list of matching topics every time it changes
+	onChange func(topics []string)
+	// pollPeriod is the interval at which the topics are polled when the broker stops supporting watchers
+	pollPeriod time.Duration
+
+	updateCh        chan *pb.CommandWatchTopicUpdate
+	connectClosedCh chan connectionClosed
+	closeOnce       sync.Once
+	closeCh         chan struct{}
+
+	log log.Logger
+}
+
+func newTopicListWatcher(client *client, tn *internal.TopicName, pattern *regexp.Regexp,
+	onChange func(topics []string), pollPeriod time.Duration, logger log.Logger) (*topicListWatcher, error) {
+	w := &topicListWatcher{
+		client:          client,
+		namespace:       tn.Namespace,
+		lookupTopic:     tn.Name,
+		pattern:         pattern,
+		watcherID:       client.rpcClient.NewConsumerID(),
+		topics:          make(map[string]struct{}),
+		onChange:        onChange,
+		pollPeriod:      pollPeriod,
+		updateCh:        make(chan *pb.CommandWatchTopicUpdate, 10),
+		connectClosedCh: make(chan connectionClosed, 10),
+		closeCh:         make(chan struct{}),
+		log:             logger,
+	}
+
+	if err := w.grabConn(); err != nil {
+		return nil, err
+	}
+
+	go w.runEventsLoop()
+
+	return w, nil
+}
+
+// TopicListUpdated is called by the connection when the broker pushes a topic list change.
+func (w *topicListWatcher) TopicListUpdated(update *pb.CommandWatchTopicUpdate) {
+	select {
+	case w.updateCh <- update:
+	case <-w.closeCh:
+	}
+}
+
+// ConnectionClosed is called by the connection when it is closed.
+func (w *topicListWatcher) ConnectionClosed() {
+	w.log.Debug("connection closed and send to connectClosedCh")
+	select {
+	case w.connectClosedCh <- connectionClosed{}:
+	case <-w.closeCh:
+	}
+}
+
+func (w *topicListWatcher) runEventsLoop() {
+	// the polling ticker is started when the broker stops supporting topic list watchers
+	var pollTicker *time.Ticker
+	var pollCh <-chan time.Time
+	defer func() {
+		if pollTicker != nil {
+			pollTicker.Stop()
+		}
+	}()
+
+	// the topics created since the topics were listed by the consumer are only known from the watch
+	w.onChange(w.topicList())
+
+	for {
+		select {
+		case <-w.closeCh:
+			return
+		case update := <-w.updateCh:
+			w.applyUpdate(update)
+		case <-w.connectClosedCh:
+			w.log.Debug("runEventsLoop will reconnect")
+			if err := w.reconnectToBroker(); err == errTopicWatcherNotSupported && pollTicker == nil {
+				w.log.Info("Broker does not support topic list watchers, falling back to polling")
+				pollTicker = time.NewTicker(w.pollPeriod)
+				pollCh = pollTicker.C
+				w.poll()
+			}
+		case <-pollCh:
+			w.poll()
+		}
+	}
+}
+
+// poll fetches the matching topics of the namespace, when the broker can not push their changes
+func (w *topicListWatcher) poll() {
+	if err := w.resync(); err != nil {
+		w.log.WithError(err).Error("Failed to fetch the topic list")
+		return
+	}
+	w.onChange(w.topicList())
+}
+
+func (w *topicListWatcher) applyUpdate(update *pb.CommandWatchTopicUpdate) {
+	for _, t := range update.GetDeletedTopics() {
+		delete(w.topics, t)
+	}
+	for _, t := range update.GetNewTopics() {
+		w.topics[t] = struct{}{}
+	}
+
+	w.hash = internal.TopicListHash(w.topicList())
+	if w.hash != update.GetTopicsHash() {
+		w.log.Warnf("Topic list hash mismatch local: %s broker: %s, fetching the complete topic list",
+			w.hash, update.GetTopicsHash())
+		if err := w.resync(); err != nil {
+			w.log.WithError(err).Error("Failed to fetch the topic list")
+		}
+	}
+
+	w.onChange(w.topicList())
+}
+
+// resync replaces the known topics with the complete list of matching topics of the namespace.
+func (w *topicListWatcher) resync() error {
+	topics, err := w.client.lookupService.GetTopicsOfNamespace(w.namespace, internal.Persistent)
+	if err != nil {
+		return err
+	}
+
+	w.topics = make(map[string]struct{}, len(topics))
+	for _, t := range matchTopics(topics, w.pattern) {
+		w.topics[t] = struct{}{}
+	}
+	w.hash = internal.TopicListHash(w.topicList())
+	return nil
+}
+
+// reconnectToBroker watches the topic list again, it gives up when the broker does not support topic list
+// watchers and returns errTopicWatcherNotSupported
+func (w *topicListWatcher) reconnectToBroker() error {
+	backoff := internal.Backoff{}
+	for {
+		select {
+		case <-w.closeCh:
+			return nil
+		default:
+		}
+
+		d := backoff.Next()
+		w.log.Info("Reconnecting topic list watcher to broker in ", d)
+		time.Sleep(d)
+
+		err := w.grabConn()
+		if err == nil {
+			w.log.Info("Reconnected topic list watcher to broker")
+			w.onChange(w.topicList())
+			return nil
+		}
+		if err == errTopicWatcherNotSupported {
+			// the connection is closed, there is no watcher left to close on the broker
+			w.cnxLock.Lock()
+			w.cnx = nil
+			w.cnxLock.Unlock()
+			return err
+		}
+		w.log.WithError(err).Warn("Failed to reconnect topic list watcher")
+	}
+}
+
+func (w *topicListWatcher) grabConn() error {
+	lr, err := w.client.lookupService.Lookup(w.lookupTopic)
+	if err != nil {
+		return err
+	}
+
+	cnx, err := w.client.cnxPool.GetConnection(lr.LogicalAddr, lr.PhysicalAddr)
+	if err != nil {
+		return err
+	}
+
+	if !cnx.IsTopicWatcherSupported() {
+		return errTopicWatcherNotSupported
+	}
+
+	requestID := w.client.rpcClient.NewRequestID()
+	cmd := &pb.CommandWatchTopicList{
+		RequestId: proto.Uint64(requestID),
+		WatcherId: proto.Uint64(w.watcherID),
+		Namespace: proto.String(w.namespace),
+		// the broker requires the whole topic name to match while the consumer only looks for a match
+		TopicsPattern: proto.String(fmt.Sprintf(".*(?:%s).*", w.pattern.String())),
+	}
+	if w.hash != "" {
+		cmd.TopicsHash = proto.String(w.hash)
+	}
+
+	cnx.AddTopicListWatcher(w.watcherID, w)
+	res, err := w.client.rpcClient.RequestOnCnx(cnx, requestID, pb.BaseCommand_WATCH_TOPIC_LIST, cmd)
+	if err != nil {
+		cnx.DeleteTopicListWatcher(w.watcherID)
+		return err
+	}
+
+	success := res.Response.GetWatchTopicListSuccess()
+	// the broker leaves out the topics when they match the hash sent with the request
+	if w.hash == "" || success.GetTopicsHash() != w.hash {
+		w.topics = make(map[string]struct{}, len(success.GetTopic()))
+		for _, t := range success.GetTopic() {
+			w.topics[t] = struct{}{}
+		}
+		w.hash = success.GetTopicsHash()
+	}
+	w.cnxLock.Lock()
+	w.cnx = cnx
+	w.cnxLock.Unlock()
+
+	return nil
+}
+
+func (w *topicListWatcher) topicList() []string {
+	topics := make([]string, 0, len(w.topics))
+	for t := range w.topics {
+		topics = append(topics, t)
+	}
+	return topics
+}
+
+func (w *topicListWatcher) close() {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+
+		w.cnxLock.Lock()
+		cnx := w.cnx
+		w.cnxLock.Unlock()
+		if cnx == nil {
+			return
+		}
+		cnx.DeleteTopicListWatcher(w.watcherID)
+
+		requestID := w.client.rpcClient.NewRequestID()
+		cmd := &pb.CommandWatchTopicListClose{
+			RequestId: proto.Uint64(requestID),
+			WatcherId: proto.Uint64(w.watcherID),
+		}
+		_, err := w.client.rpcClient.RequestOnCnx(cnx, requestID, pb.BaseCommand_WATCH_TOPIC_LIST_CLOSE, cmd)
+		if err != nil {
+			w.log.WithError(err).Warn("Failed to close topic list watcher")
+		}
+	})
+}
+
+// matchTopics returns the persistent topics, partitions included, whose name matches the pattern.
+func matchTopics(topics []string, regex *regexp.Regexp) []string {
+	matching := make([]string, 0)
+	for _, t := range topics {
+		tn, err := internal.ParseTopicName(t)
+		if err != nil || tn.Domain != "persistent" {
+			continue
+		}
+		if regex.MatchString(internal.TopicNameWithoutPartitionPart(tn)) {
+			matching = append(matching, t)
+		}
+	}
+	return matching
+}
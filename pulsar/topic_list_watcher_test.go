@@ -0,0 +1,130 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/pulsar-client-go/pulsar/internal"
+	pb "github.com/apache/pulsar-client-go/pulsar/internal/pulsar_proto"
+	"github.com/apache/pulsar-client-go/pulsar/log"
+)
+
+func TestMatchTopics(t *testing.T) {
+	topics := []string{
+		"persistent://public/default/foo-1",
+		"persistent://public/default/foo-2-partition-0",
+		"persistent://public/default/foo-2-partition-1",
+		"persistent://public/default/bar",
+		"non-persistent://public/default/foo-3",
+	}
+
+	matching := matchTopics(topics, regexp.MustCompile("foo.*"))
+	assert.Equal(t, []string{
+		"persistent://public/default/foo-1",
+		"persistent://public/default/foo-2-partition-0",
+		"persistent://public/default/foo-2-partition-1",
+	}, matching)
+}
+
+func TestTopicListWatcherApplyUpdate(t *testing.T) {
+	var changed []string
+	w := &topicListWatcher{
+		topics: map[string]struct{}{
+			"persistent://public/default/foo-1": {},
+			"persistent://public/default/foo-2": {},
+		},
+		onChange: func(topics []string) {
+			changed = topics
+		},
+		log: log.DefaultNopLogger(),
+	}
+
+	expected := []string{
+		"persistent://public/default/foo-1",
+		"persistent://public/default/foo-3",
+	}
+	w.applyUpdate(&pb.CommandWatchTopicUpdate{
+		WatcherId:     proto.Uint64(1),
+		NewTopics:     []string{"persistent://public/default/foo-3"},
+		DeletedTopics: []string{"persistent://public/default/foo-2"},
+		TopicsHash:    proto.String(internal.TopicListHash(expected)),
+	})
+
+	sort.Strings(changed)
+	assert.Equal(t, expected, changed)
+	assert.Equal(t, internal.TopicListHash(expected), w.hash)
+}
+
+type topicsOfNamespaceLookup struct {
+	internal.LookupService
+	topics []string
+}
+
+func (l *topicsOfNamespaceLookup) GetTopicsOfNamespace(namespace string,
+	mode internal.GetTopicsOfNamespaceMode) ([]string, error) {
+	return l.topics, nil
+}
+
+func TestTopicListWatcherPoll(t *testing.T) {
+	var changed []string
+	w := &topicListWatcher{
+		client: &client{lookupService: &topicsOfNamespaceLookup{topics: []string{
+			"persistent://public/default/foo-1",
+			"persistent://public/default/bar",
+			"non-persistent://public/default/foo-2",
+		}}},
+		namespace: "public/default",
+		pattern:   regexp.MustCompile("foo.*"),
+		topics:    map[string]struct{}{},
+		onChange: func(topics []string) {
+			changed = topics
+		},
+		log: log.DefaultNopLogger(),
+	}
+
+	w.poll()
+	assert.Equal(t, []string{"persistent://public/default/foo-1"}, changed)
+	assert.Equal(t, internal.TopicListHash(changed), w.hash)
+}
+
+func TestTopicListWatcherInitialTopics(t *testing.T) {
+	changed := make(chan []string, 1)
+	w := &topicListWatcher{
+		topics: map[string]struct{}{
+			"persistent://public/default/foo-1": {},
+		},
+		onChange: func(topics []string) {
+			changed <- topics
+		},
+		updateCh:        make(chan *pb.CommandWatchTopicUpdate),
+		connectClosedCh: make(chan connectionClosed),
+		closeCh:         make(chan struct{}),
+		log:             log.DefaultNopLogger(),
+	}
+
+	// the topics returned by the broker with the watch are reported
+	go w.runEventsLoop()
+	assert.Equal(t, []string{"persistent://public/default/foo-1"}, <-changed)
+	close(w.closeCh)
+}
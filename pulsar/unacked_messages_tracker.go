@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/apache/pulsar-client-go/pulsar/log"
+)
+
+// unackedMessagesTracker redelivers the messages received by the application and not acknowledged before the
+// ack timeout
+type unackedMessagesTracker struct {
+	sync.Mutex
+
+	doneCh    chan interface{}
+	doneOnce  sync.Once
+	unacked   map[messageID]time.Time
+	redeliver func(msgIds []messageID)
+	tick      *time.Ticker
+	timeout   time.Duration
+	log       log.Logger
+}
+
+func newUnackedMessagesTracker(redeliver func(msgIds []messageID), timeout time.Duration,
+	logger log.Logger) *unackedMessagesTracker {
+	t := &unackedMessagesTracker{
+		doneCh:    make(chan interface{}),
+		unacked:   make(map[messageID]time.Time),
+		redeliver: redeliver,
+		tick:      time.NewTicker(timeout / 3),
+		timeout:   timeout,
+		log:       logger,
+	}
+
+	go t.track()
+	return t
+}
+
+// batchEntry returns the id of the entry of a message, the messages of a batch are redelivered together
+func batchEntry(msgID messageID) messageID {
+	return messageID{
+		ledgerID: msgID.ledgerID,
+		entryID:  msgID.entryID,
+		batchIdx: 0,
+	}
+}
+
+func (t *unackedMessagesTracker) Add(msgID messageID) {
+	t.Lock()
+	defer t.Unlock()
+
+	batchMsgID := batchEntry(msgID)
+	if _, present := t.unacked[batchMsgID]; present {
+		// The batch is already being tracked
+		return
+	}
+	t.unacked[batchMsgID] = time.Now().Add(t.timeout)
+}
+
+func (t *unackedMessagesTracker) Remove(msgID messageID) {
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.unacked, batchEntry(msgID))
+}
+
+func (t *unackedMessagesTracker) track() {
+	for {
+		select {
+		case <-t.doneCh:
+			t.log.Debug("Closing unacked messages tracker")
+			return
+
+		case <-t.tick.C:
+			now := time.Now()
+			msgIds := make([]messageID, 0)
+
+			t.Lock()
+			for msgID, timeoutTime := range t.unacked {
+				if timeoutTime.Before(now) {
+					msgIds = append(msgIds, msgID)
+					delete(t.unacked, msgID)
+				}
+			}
+			t.Unlock()
+
+			if len(msgIds) > 0 {
+				t.log.Debugf("%d messages not acknowledged before the ack timeout", len(msgIds))
+				t.redeliver(msgIds)
+			}
+		}
+	}
+}
+
+func (t *unackedMessagesTracker) Close() {
+	// allow Close() to be invoked multiple times by consumer_partition to avoid panic
+	t.doneOnce.Do(func() {
+		t.tick.Stop()
+		t.doneCh <- nil
+	})
+}
@@ -0,0 +1,53 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnackedMessagesTracker(t *testing.T) {
+	redelivered := make(chan []messageID, 10)
+	tracker := newUnackedMessagesTracker(func(msgIds []messageID) {
+		redelivered <- msgIds
+	}, 300*time.Millisecond, log.DefaultNopLogger())
+	defer tracker.Close()
+
+	// the messages of a batch are tracked together
+	tracker.Add(messageID{ledgerID: 1, entryID: 1, batchIdx: 0})
+	tracker.Add(messageID{ledgerID: 1, entryID: 1, batchIdx: 1})
+	tracker.Add(messageID{ledgerID: 1, entryID: 2, batchIdx: -1})
+	tracker.Add(messageID{ledgerID: 1, entryID: 3, batchIdx: -1})
+	tracker.Remove(messageID{ledgerID: 1, entryID: 2, batchIdx: -1})
+
+	msgIds := sortMessageIds(<-redelivered)
+	assert.Equal(t, []messageID{
+		{ledgerID: 1, entryID: 1, batchIdx: 0},
+		{ledgerID: 1, entryID: 3, batchIdx: 0},
+	}, msgIds)
+
+	select {
+	case msgIds := <-redelivered:
+		t.Fatalf("unexpected redelivery of %v", msgIds)
+	case <-time.After(400 * time.Millisecond):
+	}
+}